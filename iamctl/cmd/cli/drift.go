@@ -0,0 +1,96 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cli
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+var driftCmd = &cobra.Command{
+	Use:   "drift",
+	Short: "Detect the drift between the target environment and the local resources",
+	Long: `You can detect the resources added, removed or changed in the target environment ` +
+		`compared to the local resources. Exits with a non-zero exit code if any drift is detected`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputDirPath, _ := cmd.Flags().GetString("inputDir")
+		configFile, _ := cmd.Flags().GetString("config")
+		reportFile, _ := cmd.Flags().GetString("report")
+
+		baseDir := loadConfigs(configFile, utils.EXPORT)
+		if inputDirPath == "" {
+			inputDirPath = baseDir
+		}
+
+		deployedDirPath, err := ioutil.TempDir("", "iamctl-drift-")
+		if err != nil {
+			log.Fatalln("Error when creating a temporary directory for the deployed resources: ", err)
+		}
+		defer os.RemoveAll(deployedDirPath)
+
+		utils.StartTime = time.Now()
+		report := utils.NewDriftReport()
+		for _, resourceType := range utils.ResourceOrder {
+			drift := utils.ResourceTypeDrift{ResourceType: resourceType}
+			if skipped, reason, err := fetchDeployedResources(resourceType, deployedDirPath, "yaml"); skipped {
+				drift.Skipped = true
+				drift.SkipReason = reason
+			} else if err != nil {
+				drift.Error = err.Error()
+			} else if drift, err = utils.DetectResourceDrift(resourceType, filepath.Join(inputDirPath, resourceType.String()),
+				filepath.Join(deployedDirPath, resourceType.String())); err != nil {
+				drift.Error = err.Error()
+			}
+			report.AddResourceTypeDrift(drift)
+		}
+
+		utils.PrintDriftReport(report)
+		if reportFile != "" {
+			if err := report.WriteToFile(reportFile); err != nil {
+				log.Println(err)
+			}
+		}
+
+		// The deferred removal of the temporary directory is not run on exit.
+		os.RemoveAll(deployedDirPath)
+		if report.HasErrors() {
+			os.Exit(utils.EXIT_CODE_RESOURCE_TYPE_FAILURE)
+		}
+		if report.Drifted {
+			os.Exit(utils.EXIT_CODE_DRIFT_DETECTED)
+		}
+	},
+}
+
+func init() {
+
+	cmd.RootCmd.AddCommand(driftCmd)
+	driftCmd.Flags().StringP("inputDir", "i", "", "Path to the input directory")
+	driftCmd.Flags().StringP("config", "c", "", "Path to the environment specific config folder")
+	driftCmd.Flags().StringP("report", "r", "", "Path to write the drift report in JSON format")
+	addRequestFlags(driftCmd)
+	driftCmd.MarkFlagRequired("config")
+}
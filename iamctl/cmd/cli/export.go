@@ -0,0 +1,79 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cli
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/client"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export <resource type>",
+	Short: "Export resources of a resource type",
+	Long:  `You can export all resources or a single resource of a resource type available in the target environment`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		outputDirPath, _ := cmd.Flags().GetString("outputDir")
+		format, _ := cmd.Flags().GetString("format")
+		configFile, _ := cmd.Flags().GetString("config")
+		resourceName, _ := cmd.Flags().GetString("name")
+		summary := getSummaryOptions(cmd)
+
+		resourceType, processedType, err := resolveResourceType(args[0])
+		if err != nil {
+			exitOnConfigFailure(err)
+		}
+
+		baseDir := loadConfigs(configFile, utils.EXPORT)
+		if outputDirPath == "" {
+			outputDirPath = baseDir
+		}
+		if err := utils.IncludeOnlyResource(resourceType, resourceName); err != nil {
+			exitOnConfigFailure(err)
+		}
+		// The local files of the other resources should not be removed when exporting a single resource.
+		if resourceName != "" {
+			utils.TOOL_CONFIGS.AllowDelete = false
+		}
+
+		handleInterrupts()
+		utils.StartTime = time.Now()
+		client.RegisterReferencedIdentifiers(resourceType, utils.EXPORT)
+		exportResourceType(processedType, outputDirPath, format)
+
+		writeManifest(outputDirPath)
+		printSummary(summary, utils.EXPORT)
+		exitOnFailures()
+	},
+}
+
+func init() {
+
+	cmd.RootCmd.AddCommand(exportCmd)
+	exportCmd.Flags().StringP("outputDir", "o", "", "Path to the output directory")
+	exportCmd.Flags().StringP("format", "f", "yaml", "Format of the exported files")
+	exportCmd.Flags().StringP("config", "c", "", "Path to the environment specific config folder")
+	exportCmd.Flags().StringP("name", "n", "", "Name of the resource to export")
+	addSummaryFlags(exportCmd)
+	addRequestFlags(exportCmd)
+}
@@ -23,27 +23,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
-	actions "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/actions"
-	apiResources "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/apiResources"
-	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/applications"
-	branding "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/branding"
-	certificates "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/certificates"
-	challengeQuestions "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/challengeQuestions"
-	claims "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/claims"
-	emailTemplates "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/emailTemplates"
-	flows "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/flows"
-	governanceConnectors "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/governanceConnectors"
-	identityproviders "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/identityProviders"
-	notificationProviders "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/notificationProviders"
-	notificationTemplates "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/notificationTemplates"
-	oidcScopes "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/oidcScopes"
-	organizations "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/organizations"
-	roles "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/roles"
-	scriptLibraries "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/scriptLibraries"
-	userstores "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/userStores"
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
-	validationRules "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/validationRules"
-	workflows "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/workflows"
 )
 
 var exportAllCmd = &cobra.Command{
@@ -54,50 +34,26 @@ var exportAllCmd = &cobra.Command{
 		outputDirPath, _ := cmd.Flags().GetString("outputDir")
 		format, _ := cmd.Flags().GetString("format")
 		configFile, _ := cmd.Flags().GetString("config")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		failFast, _ := cmd.Flags().GetBool("fail-fast")
+		setConcurrency(cmd)
+		summary := getSummaryOptions(cmd)
 
-		baseDir := utils.LoadConfigs(configFile)
+		baseDir := loadConfigs(configFile, utils.EXPORT)
 		if outputDirPath == "" {
 			outputDirPath = baseDir
 		}
 
-		exportFunctions := map[utils.ResourceType]func(string, string){
-			utils.CLAIMS:                claims.ExportAll,
-			utils.IDENTITY_PROVIDERS:    identityproviders.ExportAll,
-			utils.APPLICATIONS:          applications.ExportAll,
-			utils.USERSTORES:            userstores.ExportAll,
-			utils.OIDC_SCOPES:           oidcScopes.ExportAll,
-			utils.ROLES:                 roles.ExportAll,
-			utils.CHALLENGE_QUESTIONS:   challengeQuestions.ExportAll,
-			utils.EMAIL_TEMPLATES:       emailTemplates.ExportAll,
-			utils.SCRIPT_LIBRARIES:      scriptLibraries.ExportAll,
-			utils.GOVERNANCE_CONNECTORS: governanceConnectors.ExportAll,
-			utils.CERTIFICATES:          certificates.ExportAll,
-			utils.WORKFLOWS:             workflows.ExportAll,
-			utils.API_RESOURCES:         apiResources.ExportAll,
-			utils.VALIDATION_RULES:      validationRules.ExportAll,
-			utils.EMAIL_PROVIDERS:       notificationProviders.ExportAllEmailProviders,
-			utils.SMS_PROVIDERS:         notificationProviders.ExportAllSmsProviders,
-			utils.SMS_TEMPLATES:         notificationTemplates.ExportAllSmsTemplates,
-			utils.ACTIONS:               actions.ExportAll,
-			utils.ORGANIZATIONS:         organizations.ExportAll,
-			utils.BRANDING:              branding.ExportAll,
-			utils.FLOWS:                 flows.ExportAll,
-		}
-
+		utils.DryRun = dryRun
+		handleInterrupts()
 		utils.StartTime = time.Now()
-		for _, resourceType := range utils.ResourceOrder {
-			if exportFunc, exists := exportFunctions[resourceType]; exists {
-				if resourceType != utils.BRANDING {
-					utils.MarkResTypeStart(resourceType)
-				}
-				exportFunc(outputDirPath, format)
-				if resourceType != utils.BRANDING {
-					utils.MarkResTypeEnd(resourceType)
-				}
-			}
-		}
+		processAllResourceTypes(utils.ResourceOrder, func(resourceType utils.ResourceType) {
+			exportResourceType(resourceType, outputDirPath, format)
+		}, failFast)
 
-		utils.PrintSummary(utils.EXPORT)
+		writeManifest(outputDirPath)
+		printSummary(summary, utils.EXPORT)
+		exitOnFailures()
 	},
 }
 
@@ -107,4 +63,9 @@ func init() {
 	exportAllCmd.Flags().StringP("outputDir", "o", "", "Path to the output directory")
 	exportAllCmd.Flags().StringP("format", "f", "yaml", "Format of the exported files")
 	exportAllCmd.Flags().StringP("config", "c", "", "Path to the environment specific config folder")
+	exportAllCmd.Flags().Bool("dry-run", false, "Preview the changes without modifying the target environment or local files")
+	exportAllCmd.Flags().Bool("fail-fast", false, "Stop processing the remaining resource types after the first failure")
+	exportAllCmd.Flags().Int("concurrency", 1, "Maximum number of resource types, and of applications, identity providers and roles, processed concurrently")
+	addSummaryFlags(exportAllCmd)
+	addRequestFlags(exportAllCmd)
 }
@@ -0,0 +1,56 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cli
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+var graphCmd = &cobra.Command{
+	Use:   "graph",
+	Short: "Print the dependency graph of the resources",
+	Long: `You can print the dependency graph of the resource types in DOT or Mermaid format. ` +
+		`If an input directory is given, the dependencies between the local resources are included as well`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputDirPath, _ := cmd.Flags().GetString("inputDir")
+		format, _ := cmd.Flags().GetString("format")
+
+		graph, err := utils.BuildDependencyGraph(inputDirPath)
+		if err != nil {
+			log.Fatalln("Error building the dependency graph: ", err)
+		}
+		output, err := utils.RenderDependencyGraph(graph, format)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		fmt.Print(output)
+	},
+}
+
+func init() {
+
+	cmd.RootCmd.AddCommand(graphCmd)
+	graphCmd.Flags().StringP("inputDir", "i", "", "Path to the input directory to include the dependencies between the local resources")
+	graphCmd.Flags().StringP("format", "f", utils.GRAPH_FORMAT_DOT, "Output format of the graph: dot or mermaid")
+}
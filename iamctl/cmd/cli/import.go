@@ -0,0 +1,98 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/client"
+	identityproviders "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/identityProviders"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import <resource type>",
+	Short: "Import resources of a resource type",
+	Long:  `You can import all resources or a single resource of a resource type to the target environment`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		inputDirPath, _ := cmd.Flags().GetString("inputDir")
+		configFile, _ := cmd.Flags().GetString("config")
+		resourceName, _ := cmd.Flags().GetString("name")
+		withDependencies, _ := cmd.Flags().GetBool("with-dependencies")
+		summary := getSummaryOptions(cmd)
+
+		resourceType, processedType, err := resolveResourceType(args[0])
+		if err != nil {
+			exitOnConfigFailure(err)
+		}
+
+		baseDir := loadConfigs(configFile, utils.IMPORT)
+		if inputDirPath == "" {
+			inputDirPath = baseDir
+		}
+
+		selection := map[utils.ResourceType][]string{resourceType: nil}
+		if resourceName != "" {
+			selection[resourceType] = []string{resourceName}
+		}
+		if withDependencies {
+			if resourceName == "" {
+				exitOnConfigFailure(errors.New("a resource name is required to import the dependencies of a resource"))
+			}
+			if selection, err = utils.ResolveResourceDependencies(inputDirPath, resourceType, resourceName); err != nil {
+				exitOnConfigFailure(fmt.Errorf("error resolving the dependencies of the resource: %w", err))
+			}
+		}
+		if err := utils.IncludeOnlyResources(selection); err != nil {
+			exitOnConfigFailure(err)
+		}
+
+		handleInterrupts()
+		utils.StartTime = time.Now()
+		client.RegisterReferencedIdentifiers(resourceType, utils.IMPORT)
+		for _, currentType := range utils.ResourceOrder {
+			if _, selected := selection[currentType]; selected || currentType == processedType {
+				importResourceType(currentType, inputDirPath)
+			}
+		}
+		if _, selected := selection[utils.IDENTITY_PROVIDERS]; selected && !utils.IsInterrupted() {
+			identityproviders.RemoveDeletedDeployedIdps(inputDirPath)
+		}
+
+		printSummary(summary, utils.IMPORT)
+		exitOnFailures()
+	},
+}
+
+func init() {
+
+	cmd.RootCmd.AddCommand(importCmd)
+	importCmd.Flags().StringP("inputDir", "i", "", "Path to the input directory")
+	importCmd.Flags().StringP("config", "c", "", "Path to the environment specific config folder")
+	importCmd.Flags().StringP("name", "n", "", "Name of the resource to import")
+	importCmd.Flags().Bool("with-dependencies", false, "Import the local resources the selected resource depends on as well")
+	addSummaryFlags(importCmd)
+	addRequestFlags(importCmd)
+	importCmd.MarkFlagRequired("config")
+}
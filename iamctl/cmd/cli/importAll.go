@@ -19,31 +19,16 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
+	"log"
+	"path/filepath"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
-	actions "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/actions"
-	apiResources "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/apiResources"
-	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/applications"
-	branding "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/branding"
-	certificates "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/certificates"
-	challengeQuestions "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/challengeQuestions"
-	claims "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/claims"
-	emailTemplates "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/emailTemplates"
-	flows "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/flows"
-	governanceConnectors "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/governanceConnectors"
 	identityproviders "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/identityProviders"
-	notificationProviders "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/notificationProviders"
-	notificationTemplates "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/notificationTemplates"
-	oidcScopes "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/oidcScopes"
-	organizations "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/organizations"
-	roles "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/roles"
-	scriptLibraries "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/scriptLibraries"
-	userstores "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/userStores"
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
-	validationRules "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/validationRules"
-	workflows "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/workflows"
 )
 
 var importAllCmd = &cobra.Command{
@@ -53,53 +38,76 @@ var importAllCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		inputDirPath, _ := cmd.Flags().GetString("inputDir")
 		configFile, _ := cmd.Flags().GetString("config")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		failFast, _ := cmd.Flags().GetBool("fail-fast")
+		resume, _ := cmd.Flags().GetBool("resume")
+		checkpointFile, _ := cmd.Flags().GetString("checkpoint-file")
+		snapshot, _ := cmd.Flags().GetBool("snapshot")
+		snapshotDirPath, _ := cmd.Flags().GetString("snapshot-dir")
+		since, _ := cmd.Flags().GetString("since")
+		changedFiles, _ := cmd.Flags().GetStringSlice("changed-files")
+		setConcurrency(cmd)
+		summary := getSummaryOptions(cmd)
+		if since != "" && len(changedFiles) > 0 {
+			exitOnConfigFailure(errors.New("the --since and --changed-files flags cannot be used together"))
+		}
 
-		baseDir := utils.LoadConfigs(configFile)
+		baseDir := loadConfigs(configFile, utils.IMPORT)
 		if inputDirPath == "" {
 			inputDirPath = baseDir
 		}
 
-		importFunctions := map[utils.ResourceType]func(string){
-			utils.CLAIMS:                claims.ImportAll,
-			utils.IDENTITY_PROVIDERS:    identityproviders.ImportAll,
-			utils.APPLICATIONS:          applications.ImportAll,
-			utils.USERSTORES:            userstores.ImportAll,
-			utils.OIDC_SCOPES:           oidcScopes.ImportAll,
-			utils.ROLES:                 roles.ImportAll,
-			utils.CHALLENGE_QUESTIONS:   challengeQuestions.ImportAll,
-			utils.EMAIL_TEMPLATES:       emailTemplates.ImportAll,
-			utils.SCRIPT_LIBRARIES:      scriptLibraries.ImportAll,
-			utils.GOVERNANCE_CONNECTORS: governanceConnectors.ImportAll,
-			utils.CERTIFICATES:          certificates.ImportAll,
-			utils.WORKFLOWS:             workflows.ImportAll,
-			utils.API_RESOURCES:         apiResources.ImportAll,
-			utils.VALIDATION_RULES:      validationRules.ImportAll,
-			utils.EMAIL_PROVIDERS:       notificationProviders.ImportAllEmailProviders,
-			utils.SMS_PROVIDERS:         notificationProviders.ImportAllSmsProviders,
-			utils.SMS_TEMPLATES:         notificationTemplates.ImportAllSmsTemplates,
-			utils.ACTIONS:               actions.ImportAll,
-			utils.ORGANIZATIONS:         organizations.ImportAll,
-			utils.BRANDING:              branding.ImportAll,
-			utils.FLOWS:                 flows.ImportAll,
+		resourceTypes := utils.ResourceOrder
+		if since != "" || len(changedFiles) > 0 {
+			resourceTypes = selectChangedResources(inputDirPath, since, changedFiles)
+			if len(resourceTypes) == 0 {
+				log.Println("No changed resources to import.")
+				printSummary(summary, utils.IMPORT)
+				return
+			}
 		}
 
-		utils.StartTime = time.Now()
-		for _, resourceType := range utils.ResourceOrder {
-			if importFunc, exists := importFunctions[resourceType]; exists {
-				if resourceType != utils.BRANDING {
-					utils.MarkResTypeStart(resourceType)
-				}
-				importFunc(inputDirPath)
-				if resourceType != utils.BRANDING {
-					utils.MarkResTypeEnd(resourceType)
-				}
+		warnRenamedResources(inputDirPath)
+
+		if checkpointFile == "" {
+			checkpointFile = filepath.Join(inputDirPath, utils.CHECKPOINT_FILE_NAME)
+		}
+
+		utils.DryRun = dryRun
+		// A resumed import keeps the snapshot taken before the failed run.
+		if snapshot && !dryRun && !resume {
+			if snapshotDirPath == "" {
+				snapshotDirPath = utils.GetDefaultSnapshotDirPath()
 			}
+			createSnapshot(resourceTypes, inputDirPath, snapshotDirPath)
+			warnServerModifications(inputDirPath, snapshotDirPath)
 		}
 
+		completedTypes, err := utils.InitCheckpoint(checkpointFile, resume)
+		if err != nil {
+			exitOnConfigFailure(fmt.Errorf("error resuming the import from the checkpoint: %w", err))
+		}
+		skipResourceTypes(completedTypes, RESUME_SKIP_REASON)
+
+		handleInterrupts()
+		utils.StartTime = time.Now()
+		processAllResourceTypes(excludeResourceTypes(resourceTypes, completedTypes), func(resourceType utils.ResourceType) {
+			importResourceType(resourceType, inputDirPath)
+			if !hasResourceTypeFailures(resourceType) && !utils.IsInterrupted() {
+				utils.MarkResourceTypeCompleted(resourceType)
+			}
+		}, failFast)
+
 		// Delete identity providers after deleting associated applications
-		identityproviders.RemoveDeletedDeployedIdps(inputDirPath)
+		if !(failFast && utils.HasFailures()) && !utils.IsInterrupted() {
+			identityproviders.RemoveDeletedDeployedIdps(inputDirPath)
+		}
 
-		utils.PrintSummary(utils.IMPORT)
+		if !utils.HasFailures() && !utils.IsInterrupted() {
+			utils.RemoveCheckpoint()
+		}
+		printSummary(summary, utils.IMPORT)
+		exitOnFailures()
 	},
 }
 
@@ -108,5 +116,78 @@ func init() {
 	cmd.RootCmd.AddCommand(importAllCmd)
 	importAllCmd.Flags().StringP("inputDir", "i", "", "Path to the input directory")
 	importAllCmd.Flags().StringP("config", "c", "", "Path to the environment specific config folder")
+	importAllCmd.Flags().Bool("dry-run", false, "Preview the changes without modifying the target environment or local files")
+	importAllCmd.Flags().Bool("fail-fast", false, "Stop processing the remaining resource types after the first failure")
+	importAllCmd.Flags().Int("concurrency", 1, "Maximum number of resource types, and of applications, identity providers and roles, processed concurrently")
+	importAllCmd.Flags().Bool("resume", false, "Resume a failed import from the checkpoint file")
+	importAllCmd.Flags().String("checkpoint-file", "", "Path to the checkpoint file (default \"<inputDir>/"+utils.CHECKPOINT_FILE_NAME+"\")")
+	importAllCmd.Flags().Bool("snapshot", true, "Export the deployed resources to be updated or deleted before importing")
+	importAllCmd.Flags().String("snapshot-dir", "", "Path to the snapshot directory (default \"~/"+
+		utils.MANIFEST_DIR_NAME+"/"+utils.SNAPSHOTS_DIR_NAME+"/<timestamp>\")")
+	importAllCmd.Flags().String("since", "", "Import only the resources changed since the given git reference")
+	importAllCmd.Flags().StringSlice("changed-files", nil, "Import only the resources of the given changed files, relative to the input directory")
+	addSummaryFlags(importAllCmd)
+	addRequestFlags(importAllCmd)
 	importAllCmd.MarkFlagRequired("config")
 }
+
+// Exports the deployed resources of the given resource types the import is about to update or delete to the
+// snapshot directory, so that the changes of the import can be rolled back. The import continues with a warning
+// if the snapshot of a resource type, or the whole snapshot, cannot be created.
+func createSnapshot(resourceTypes []utils.ResourceType, inputDirPath, snapshotDirPath string) {
+
+	log.Println("Creating a snapshot of the target environment in: " + snapshotDirPath)
+	var warnings []string
+	plans := buildPlan(resourceTypes, inputDirPath, snapshotDirPath, "yaml")
+	for i, plan := range plans {
+		if plan.Error != "" {
+			warnings = append(warnings, fmt.Sprintf("Error creating the snapshot of %s: %s. The changes to %s "+
+				"cannot be rolled back.", plan.ResourceType, plan.Error, plan.ResourceType))
+			plans[i].Skipped = true
+		}
+	}
+	if _, err := utils.CreateSnapshot(snapshotDirPath, plans); err != nil {
+		warnings = append(warnings, fmt.Sprintf("Error creating the snapshot: %s. The changes of the import "+
+			"cannot be rolled back.", err))
+	}
+
+	// The export of the snapshot is not a part of the import.
+	utils.ResetSummary()
+	utils.ResetResourceIdentifierMap()
+	for _, warning := range warnings {
+		utils.PrintLog(utils.LogLevelWarn, utils.UtilsResourceWrapper, "", warning)
+	}
+}
+
+// Limits the import to the resources changed since the given git reference, or to the resources of the given changed
+// files, and the local resources depending on them. Returns the resource types to be processed.
+func selectChangedResources(inputDirPath, since string, changedFiles []string) []utils.ResourceType {
+
+	if since != "" {
+		var err error
+		if changedFiles, err = utils.GetChangedFilesSince(inputDirPath, since); err != nil {
+			exitOnConfigFailure(err)
+		}
+	}
+	for i, changedFile := range changedFiles {
+		if filepath.IsAbs(changedFile) {
+			if relativePath, err := filepath.Rel(inputDirPath, changedFile); err == nil {
+				changedFiles[i] = relativePath
+			}
+		}
+	}
+
+	selection := utils.ResolveChangedResources(changedFiles)
+	if err := utils.AddDependentResources(inputDirPath, selection); err != nil {
+		exitOnConfigFailure(fmt.Errorf("error resolving the resources depending on the changed resources: %w", err))
+	}
+	utils.RemoveExcludedResources(selection)
+	if len(selection) == 0 {
+		return nil
+	}
+	if err := utils.IncludeOnlyResources(selection); err != nil {
+		exitOnConfigFailure(err)
+	}
+	log.Printf("Importing the changed resources of %d resource types.", len(selection))
+	return utils.GetSelectedResourceTypes(selection)
+}
@@ -0,0 +1,130 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cli
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+var planCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "Preview the changes of an import",
+	Long: `You can preview the resources that would be created, updated or deleted ` +
+		`by importing the local resources to the target environment`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputDirPath, _ := cmd.Flags().GetString("inputDir")
+		format, _ := cmd.Flags().GetString("format")
+		configFile, _ := cmd.Flags().GetString("config")
+
+		baseDir := loadConfigs(configFile, utils.EXPORT)
+		if inputDirPath == "" {
+			inputDirPath = baseDir
+		}
+
+		deployedDirPath, err := ioutil.TempDir("", "iamctl-plan-")
+		if err != nil {
+			log.Fatalln("Error when creating a temporary directory for the deployed resources: ", err)
+		}
+		defer os.RemoveAll(deployedDirPath)
+
+		utils.StartTime = time.Now()
+		utils.PrintPlan(buildPlan(utils.ResourceOrder, inputDirPath, deployedDirPath, format))
+	},
+}
+
+func init() {
+
+	cmd.RootCmd.AddCommand(planCmd)
+	planCmd.Flags().StringP("inputDir", "i", "", "Path to the input directory")
+	planCmd.Flags().StringP("format", "f", "yaml", "Format used to fetch the deployed resources")
+	planCmd.Flags().StringP("config", "c", "", "Path to the environment specific config folder")
+	addRequestFlags(planCmd)
+	planCmd.MarkFlagRequired("config")
+}
+
+// Exports the deployed resources to the given directory and compares them with the local resources.
+func buildPlan(resourceTypes []utils.ResourceType, inputDirPath, deployedDirPath, format string) []utils.ResourceTypePlan {
+
+	var plans []utils.ResourceTypePlan
+	for _, resourceType := range resourceTypes {
+		plan := utils.ResourceTypePlan{ResourceType: resourceType}
+		if skipped, reason, err := fetchDeployedResources(resourceType, deployedDirPath, format); skipped {
+			plan.Skipped = true
+			plan.SkipReason = reason
+		} else if err != nil {
+			plan.Error = err.Error()
+		} else {
+			changes, err := utils.CompareResourceDirs(resourceType, filepath.Join(inputDirPath, resourceType.String()),
+				filepath.Join(deployedDirPath, resourceType.String()))
+			if err != nil {
+				plan.Error = err.Error()
+			}
+			plan.Changes = changes
+		}
+		plans = append(plans, plan)
+	}
+	return plans
+}
+
+// Exports the deployed resources of a resource type to the given directory. Returns whether the resource type is
+// skipped, and an error if the deployed resources could not be fetched.
+func fetchDeployedResources(resourceType utils.ResourceType, deployedDirPath, format string) (bool, string, error) {
+
+	exportResourceType(resourceType, deployedDirPath, format)
+	if skipped, reason := isResourceTypeSkipped(resourceType); skipped {
+		return true, reason, nil
+	}
+	if isResourceTypeFailed(resourceType) {
+		return false, "", errors.New("error when fetching the deployed resources")
+	}
+	return false, "", nil
+}
+
+func isResourceTypeSkipped(resourceType utils.ResourceType) (bool, string) {
+
+	for _, summaryType := range getSummaryTypes(resourceType) {
+		summary, exists := utils.GetResTypeSummary(summaryType)
+		if !exists || !summary.Skipped {
+			return false, ""
+		}
+		if resourceType != utils.BRANDING {
+			return true, summary.SkipReason
+		}
+	}
+	return true, "all sub resource types are skipped"
+}
+
+func isResourceTypeFailed(resourceType utils.ResourceType) bool {
+
+	for _, summaryType := range getSummaryTypes(resourceType) {
+		if summary, exists := utils.GetResTypeSummary(summaryType); exists && summary.Failed {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/client"
+	identityproviders "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/identityProviders"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+var promoteCmd = &cobra.Command{
+	Use:   "promote",
+	Short: "Promote all resources from one environment to another",
+	Long: `You can export all resources from a source environment and import them to a target environment in a single run. ` +
+		`The values of the keyword mappings of the source environment are replaced with their keywords before importing. ` +
+		`Values shorter than ` + strconv.Itoa(utils.MIN_KEYWORD_PLACEHOLDER_VALUE_LENGTH) + ` characters are not replaced`,
+	Run: func(cmd *cobra.Command, args []string) {
+		fromConfig, _ := cmd.Flags().GetString("from")
+		toConfig, _ := cmd.Flags().GetString("to")
+		format, _ := cmd.Flags().GetString("format")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		failFast, _ := cmd.Flags().GetBool("fail-fast")
+		snapshot, _ := cmd.Flags().GetBool("snapshot")
+		snapshotDirPath, _ := cmd.Flags().GetString("snapshot-dir")
+		setConcurrency(cmd)
+		summary := getSummaryOptions(cmd)
+
+		source := newClient(fromConfig, utils.EXPORT)
+		target := newClient(toConfig, utils.IMPORT)
+
+		workDirPath, err := ioutil.TempDir("", utils.PROMOTE_DIR_PREFIX)
+		if err != nil {
+			exitOnConfigFailure(fmt.Errorf("error creating the temporary directory: %w", err))
+		}
+		handleInterrupts()
+
+		// The resources are not imported unless all of them are exported, as the missing resources
+		// would be deleted from the target environment.
+		exitCode := utils.EXIT_CODE_SUCCESS
+		source.Session().Run(func() {
+			log.Println("Exporting the resources from: " + fromConfig)
+			utils.StartTime = time.Now()
+			processAllResourceTypes(utils.ResourceOrder, func(resourceType utils.ResourceType) {
+				exportResourceType(resourceType, workDirPath, format)
+			}, true)
+			if exitCode = utils.GetExitCode(); exitCode != utils.EXIT_CODE_SUCCESS {
+				utils.PrintSummary(utils.EXPORT)
+				log.Println("Resources are not imported as the export from the source environment did not complete.")
+				return
+			}
+			modified, err := utils.AddKeywordPlaceholders(workDirPath)
+			if err != nil {
+				log.Println("Error adding the keyword placeholders: ", err)
+				exitCode = utils.EXIT_CODE_PARTIAL_FAILURE
+				return
+			}
+			utils.PrintLog(utils.LogLevelInfo, utils.UtilsResourceWrapper, "",
+				fmt.Sprintf("Keyword placeholders added to %d exported files.", modified))
+		})
+		if exitCode != utils.EXIT_CODE_SUCCESS {
+			os.RemoveAll(workDirPath)
+			os.Exit(exitCode)
+		}
+
+		target.Session().Run(func() {
+			log.Println("Importing the resources to: " + toConfig)
+			utils.DryRun = dryRun
+			if snapshot && !dryRun {
+				if snapshotDirPath == "" {
+					snapshotDirPath = utils.GetDefaultSnapshotDirPath()
+				}
+				createSnapshot(utils.ResourceOrder, workDirPath, snapshotDirPath)
+			}
+			utils.StartTime = time.Now()
+			processAllResourceTypes(utils.ResourceOrder, func(resourceType utils.ResourceType) {
+				importResourceType(resourceType, workDirPath)
+			}, failFast)
+
+			// Delete identity providers after deleting associated applications
+			if !(failFast && utils.HasFailures()) && !utils.IsInterrupted() {
+				identityproviders.RemoveDeletedDeployedIdps(workDirPath)
+			}
+			printSummary(summary, utils.IMPORT)
+			exitCode = utils.GetExitCode()
+		})
+		os.RemoveAll(workDirPath)
+		if exitCode != utils.EXIT_CODE_SUCCESS {
+			os.Exit(exitCode)
+		}
+	},
+}
+
+func init() {
+
+	cmd.RootCmd.AddCommand(promoteCmd)
+	promoteCmd.Flags().String("from", "", "Path to the config folder of the source environment")
+	promoteCmd.Flags().String("to", "", "Path to the config folder of the target environment")
+	promoteCmd.Flags().StringP("format", "f", "yaml", "Format of the exported files")
+	promoteCmd.Flags().Bool("dry-run", false, "Preview the changes without modifying the target environment")
+	promoteCmd.Flags().Bool("fail-fast", false, "Stop importing the remaining resource types after the first failure")
+	promoteCmd.Flags().Int("concurrency", 1, "Maximum number of resource types, and of applications, identity providers and roles, processed concurrently")
+	promoteCmd.Flags().Bool("snapshot", true, "Export the deployed resources to be updated or deleted before importing")
+	promoteCmd.Flags().String("snapshot-dir", "", "Path to the snapshot directory (default \"~/"+
+		utils.MANIFEST_DIR_NAME+"/"+utils.SNAPSHOTS_DIR_NAME+"/<timestamp>\")")
+	addSummaryFlags(promoteCmd)
+	addRequestFlags(promoteCmd)
+	promoteCmd.MarkFlagRequired("from")
+	promoteCmd.MarkFlagRequired("to")
+}
+
+// Creates a client for the environment with the given config folder. Exits with the config failure exit code if the
+// configs cannot be loaded or an access token cannot be obtained.
+func newClient(configFile, operation string) *client.Client {
+
+	envClient, err := client.New(configFile, operation)
+	if err != nil {
+		log.Println("ERROR: Utils -", err)
+		os.Exit(utils.EXIT_CODE_CONFIG_FAILURE)
+	}
+	return envClient
+}
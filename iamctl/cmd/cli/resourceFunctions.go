@@ -0,0 +1,332 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/client"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+const (
+	FAIL_FAST_SKIP_REASON = "Skipped due to a previous failure (fail-fast)"
+	RESUME_SKIP_REASON    = "Completed in a previous run (resume)"
+	INTERRUPT_SKIP_REASON = "Not processed as the run was interrupted"
+)
+
+// Resolves the resource type given as a command argument. Sub resource types of branding are also accepted.
+// Returns the resource type and the resource type to be processed to handle it.
+func resolveResourceType(arg string) (resourceType utils.ResourceType, processedType utils.ResourceType, err error) {
+
+	for _, subType := range getSummaryTypes(utils.BRANDING) {
+		if strings.EqualFold(arg, subType.String()) {
+			return subType, utils.BRANDING, nil
+		}
+	}
+	for _, resourceType := range utils.ResourceOrder {
+		if strings.EqualFold(arg, resourceType.String()) {
+			return resourceType, resourceType, nil
+		}
+	}
+	return "", "", fmt.Errorf("unsupported resource type: %s", arg)
+}
+
+func exportResourceType(resourceType utils.ResourceType, outputDirPath, format string) {
+
+	if !client.IsSupported(resourceType) || skipIfInterrupted(resourceType) {
+		return
+	}
+	client.ExportResourceType(resourceType, outputDirPath, format)
+	markIfInterrupted(resourceType)
+}
+
+func importResourceType(resourceType utils.ResourceType, inputDirPath string) {
+
+	if !client.IsSupported(resourceType) || skipIfInterrupted(resourceType) {
+		return
+	}
+	client.ImportResourceType(resourceType, inputDirPath)
+	markIfInterrupted(resourceType)
+}
+
+// Marks the resource type as skipped if the run is interrupted before it is started.
+func skipIfInterrupted(resourceType utils.ResourceType) bool {
+
+	if !utils.IsInterrupted() {
+		return false
+	}
+	skipResourceTypes([]utils.ResourceType{resourceType}, INTERRUPT_SKIP_REASON)
+	return true
+}
+
+// Marks the resource type as interrupted if the run is interrupted while it is processed.
+func markIfInterrupted(resourceType utils.ResourceType) {
+
+	if !utils.IsInterrupted() {
+		return
+	}
+	for _, summaryType := range getSummaryTypes(resourceType) {
+		utils.MarkResTypeInterrupted(summaryType)
+	}
+}
+
+// Returns the resource types under which the summary of a resource type is recorded.
+func getSummaryTypes(resourceType utils.ResourceType) []utils.ResourceType {
+
+	if resourceType == utils.BRANDING {
+		return []utils.ResourceType{utils.BRANDING_PREFERENCES, utils.CUSTOM_TEXTS}
+	}
+	return []utils.ResourceType{resourceType}
+}
+
+// Processes the resource types in the dependency order, concurrently if a concurrency is configured.
+// With fail-fast, no new resource types are started after the first failure and the remaining ones are marked as skipped.
+// The same applies once the run is interrupted.
+func processAllResourceTypes(resourceTypes []utils.ResourceType, process func(utils.ResourceType), failFast bool) {
+
+	unprocessed := utils.ProcessResourceTypes(resourceTypes, process, func() bool {
+		return utils.IsInterrupted() || (failFast && utils.HasFailures())
+	})
+	if utils.IsInterrupted() {
+		skipResourceTypes(unprocessed, INTERRUPT_SKIP_REASON)
+	} else {
+		skipResourceTypes(unprocessed, FAIL_FAST_SKIP_REASON)
+	}
+}
+
+// Returns the given resource types without the excluded ones.
+func excludeResourceTypes(resourceTypes, excluded []utils.ResourceType) []utils.ResourceType {
+
+	var remaining []utils.ResourceType
+	for _, resourceType := range resourceTypes {
+		isExcluded := false
+		for _, excludedType := range excluded {
+			if resourceType == excludedType {
+				isExcluded = true
+				break
+			}
+		}
+		if !isExcluded {
+			remaining = append(remaining, resourceType)
+		}
+	}
+	return remaining
+}
+
+// Checks whether the resource type, or any of its resources, failed to be processed.
+func hasResourceTypeFailures(resourceType utils.ResourceType) bool {
+
+	for _, summaryType := range getSummaryTypes(resourceType) {
+		if summary, exists := utils.GetResTypeSummary(summaryType); exists && (summary.Failed || summary.FailedCount > 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// Loads the configs for the given operation. Exits with the config failure exit code if the configs cannot be loaded
+// or an access token cannot be obtained, as no resources can be processed without them.
+func loadConfigs(configFile, operation string) string {
+
+	baseDir, err := utils.LoadConfigs(configFile, operation)
+	if err != nil {
+		exitOnConfigFailure(err)
+	}
+	return baseDir
+}
+
+// Exits with the config failure exit code on invalid configs or command flags, before any resources are processed.
+func exitOnConfigFailure(err error) {
+
+	log.Println("ERROR: Utils -", err)
+	os.Exit(utils.EXIT_CODE_CONFIG_FAILURE)
+}
+
+// Writes the manifest of the exported resources. The export does not fail if the manifest cannot be written.
+func writeManifest(outputDirPath string) {
+
+	if err := utils.WriteManifest(outputDirPath); err != nil {
+		utils.PrintLog(utils.LogLevelWarn, utils.UtilsResourceWrapper, "", "Error writing the export manifest: "+err.Error())
+	}
+}
+
+// Warns about the local resources renamed since they were exported, as they are deleted and created again
+// in the target environment instead of being renamed.
+func warnRenamedResources(inputDirPath string) {
+
+	renames, err := utils.DetectRenamedResources(inputDirPath)
+	if err != nil {
+		utils.PrintLog(utils.LogLevelWarn, utils.UtilsResourceWrapper, "", "Error reading the export manifest: "+err.Error())
+		return
+	}
+	for _, rename := range renames {
+		utils.PrintLog(utils.LogLevelWarn, rename.ResourceType, rename.NewName, fmt.Sprintf("Renamed from %s since the "+
+			"export. The resource is created as a new resource and %s is deleted if deleting resources is allowed.",
+			rename.OldName, rename.OldName))
+	}
+}
+
+// Warns about the resources modified in the target environment since they were exported, given the deployed
+// resources exported to the snapshot directory, as the import overwrites the modifications.
+func warnServerModifications(inputDirPath, snapshotDirPath string) {
+
+	manifest, err := utils.LoadManifest(inputDirPath)
+	if err != nil {
+		utils.PrintLog(utils.LogLevelWarn, utils.UtilsResourceWrapper, "", "Error reading the export manifest: "+err.Error())
+		return
+	}
+	modified, err := utils.DetectServerModifications(manifest, snapshotDirPath)
+	if err != nil {
+		utils.PrintLog(utils.LogLevelWarn, utils.UtilsResourceWrapper, "", "Error detecting the server side modifications: "+err.Error())
+		return
+	}
+	for _, resource := range modified {
+		utils.PrintLog(utils.LogLevelWarn, resource.ResourceType, resource.Name, "Modified in the server since the export. "+
+			"The import overwrites the modifications.")
+	}
+}
+
+// Sets the concurrency given as a command flag.
+func setConcurrency(cmd *cobra.Command) {
+
+	concurrency, _ := cmd.Flags().GetInt("concurrency")
+	if err := utils.SetConcurrency(concurrency); err != nil {
+		exitOnConfigFailure(err)
+	}
+}
+
+// Output options of the summary of a command.
+type summaryOptions struct {
+	format   string
+	filePath string
+}
+
+func addSummaryFlags(cmd *cobra.Command) {
+
+	cmd.Flags().String("summary-format", utils.SUMMARY_FORMAT_TEXT, "Format of the summary: text, json or junit")
+	cmd.Flags().String("summary-file", "", "Path to write the summary in the json or junit format")
+}
+
+// Adds the flags for tracing, recording and replaying the requests of a command. These are set up before the
+// command runs, so that the requests sent while loading the configs are included as well.
+func addRequestFlags(cmd *cobra.Command) {
+
+	cmd.Flags().String("trace-file", "", "Path to write a HAR-like trace of all the requests and responses")
+	cmd.Flags().String("correlation-id", "", "Correlation ID to send with the requests instead of a generated one")
+	cmd.Flags().String("record", "", "Path to record all the requests and responses in a cassette file")
+	cmd.Flags().String("replay", "", "Path to a recorded cassette file to replay the responses from, without a server")
+	cmd.PreRun = func(cmd *cobra.Command, args []string) {
+		if correlationId, _ := cmd.Flags().GetString("correlation-id"); correlationId != "" {
+			utils.SetCorrelationId(correlationId)
+		}
+		if traceFile, _ := cmd.Flags().GetString("trace-file"); traceFile != "" {
+			if err := utils.StartTrace(traceFile); err != nil {
+				exitOnConfigFailure(err)
+			}
+		}
+		recordFile, _ := cmd.Flags().GetString("record")
+		replayFile, _ := cmd.Flags().GetString("replay")
+		if recordFile != "" && replayFile != "" {
+			exitOnConfigFailure(errors.New("the --record and --replay flags cannot be used together"))
+		}
+		if recordFile != "" {
+			if err := utils.StartRecording(recordFile); err != nil {
+				exitOnConfigFailure(err)
+			}
+		}
+		if replayFile != "" {
+			if err := utils.StartReplay(replayFile); err != nil {
+				exitOnConfigFailure(err)
+			}
+		}
+	}
+}
+
+// Reads the summary options given as command flags, so that they are validated before processing any resources.
+func getSummaryOptions(cmd *cobra.Command) summaryOptions {
+
+	format, _ := cmd.Flags().GetString("summary-format")
+	filePath, _ := cmd.Flags().GetString("summary-file")
+	if err := utils.ValidateSummaryFormat(format); err != nil {
+		exitOnConfigFailure(err)
+	}
+	if filePath != "" && format == utils.SUMMARY_FORMAT_TEXT {
+		exitOnConfigFailure(errors.New("the summary file requires the json or junit summary format"))
+	}
+	return summaryOptions{format: format, filePath: filePath}
+}
+
+// Prints the summary of a command. A machine-readable summary is written to the summary file along with the text
+// summary, or printed instead of the text summary if a summary file is not given.
+func printSummary(options summaryOptions, operation string) {
+
+	if options.format == utils.SUMMARY_FORMAT_TEXT || options.filePath != "" {
+		utils.PrintSummary(operation)
+	}
+	if options.format == utils.SUMMARY_FORMAT_TEXT {
+		return
+	}
+
+	data, err := utils.FormatSummaryReport(utils.BuildSummaryReport(operation), options.format)
+	if err != nil {
+		log.Println("Error generating the summary: ", err)
+		return
+	}
+	if options.filePath == "" {
+		fmt.Println(string(data))
+	} else if err := ioutil.WriteFile(options.filePath, data, 0644); err != nil {
+		log.Println("Error writing the summary file: ", err)
+	}
+}
+
+// Marks the given resource types as skipped, so that they are listed in the summary.
+func skipResourceTypes(resourceTypes []utils.ResourceType, reason string) {
+
+	for _, resourceType := range resourceTypes {
+		for _, summaryType := range getSummaryTypes(resourceType) {
+			utils.UpdateSkipSummary(summaryType, reason)
+		}
+	}
+}
+
+// Handles the interrupts of the run, and exits immediately with the interrupted exit code on the third interrupt.
+func handleInterrupts() {
+
+	forceExit := utils.HandleInterrupts()
+	go func() {
+		<-forceExit
+		os.Exit(utils.EXIT_CODE_INTERRUPTED)
+	}()
+}
+
+// Exits with a non-zero exit code if any failures are recorded in the summary.
+func exitOnFailures() {
+
+	if exitCode := utils.GetExitCode(); exitCode != utils.EXIT_CODE_SUCCESS {
+		os.Exit(exitCode)
+	}
+}
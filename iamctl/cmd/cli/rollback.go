@@ -0,0 +1,98 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cli
+
+import (
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/client"
+	identityproviders "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/identityProviders"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+var rollbackCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "Roll back the changes of an import",
+	Long: `You can roll back the changes of an import using the snapshot taken before it. ` +
+		`The updated and deleted resources are restored and the created resources are deleted`,
+	Run: func(cmd *cobra.Command, args []string) {
+		snapshotDirPath, _ := cmd.Flags().GetString("snapshot")
+		configFile, _ := cmd.Flags().GetString("config")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		summary := getSummaryOptions(cmd)
+
+		loadConfigs(configFile, utils.ROLLBACK)
+		manifest, err := utils.LoadSnapshot(snapshotDirPath)
+		if err != nil {
+			exitOnConfigFailure(err)
+		}
+
+		selection := manifest.GetRollbackSelection()
+		if len(selection) == 0 {
+			log.Println("No changes to roll back.")
+			return
+		}
+		if err := utils.IncludeOnlyResources(selection); err != nil {
+			exitOnConfigFailure(err)
+		}
+		// The resources created by the import are not in the snapshot, hence deleted.
+		utils.TOOL_CONFIGS.AllowDelete = true
+		utils.DryRun = dryRun
+
+		handleInterrupts()
+		utils.StartTime = time.Now()
+		for _, resourceType := range utils.ResourceOrder {
+			if isResourceTypeSelected(resourceType, selection) {
+				client.RegisterReferencedIdentifiers(resourceType, utils.IMPORT)
+				importResourceType(resourceType, snapshotDirPath)
+			}
+		}
+		if _, selected := selection[utils.IDENTITY_PROVIDERS]; selected && !utils.IsInterrupted() {
+			identityproviders.RemoveDeletedDeployedIdps(snapshotDirPath)
+		}
+
+		printSummary(summary, utils.IMPORT)
+		exitOnFailures()
+	},
+}
+
+func init() {
+
+	cmd.RootCmd.AddCommand(rollbackCmd)
+	rollbackCmd.Flags().String("snapshot", "", "Path to the snapshot directory")
+	rollbackCmd.Flags().StringP("config", "c", "", "Path to the environment specific config folder")
+	rollbackCmd.Flags().Bool("dry-run", false, "Preview the changes without modifying the target environment")
+	addSummaryFlags(rollbackCmd)
+	addRequestFlags(rollbackCmd)
+	rollbackCmd.MarkFlagRequired("snapshot")
+	rollbackCmd.MarkFlagRequired("config")
+}
+
+func isResourceTypeSelected(resourceType utils.ResourceType, selection map[utils.ResourceType][]string) bool {
+
+	for _, summaryType := range getSummaryTypes(resourceType) {
+		if _, selected := selection[summaryType]; selected {
+			return true
+		}
+	}
+	return false
+}
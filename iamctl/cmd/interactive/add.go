@@ -24,7 +24,6 @@ import (
 	"net/url"
 
 	"github.com/spf13/cobra"
-	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
 )
 
 var createUsingCommand = &cobra.Command{
@@ -45,7 +44,7 @@ var createUsingCommand = &cobra.Command{
 			password, _ := cmd.Flags().GetString("password")
 
 			if userName == "" && password == "" {
-				token := utils.ReadFile()
+				token := readAccessToken()
 				if token == "" {
 					fmt.Println("required flag(s) \"password\",\"userName\" not set \nFlags:\n-u, --userName string       Username for Identity Server\n-p, --password string       Password for Identity Server")
 					return
@@ -58,35 +57,35 @@ var createUsingCommand = &cobra.Command{
 					fmt.Println("required flag(s) \"userName\" not set \nFlag:\n-u, --userName string       Username for Identity Server ")
 					return
 				} else {
-					SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = utils.ReadSPConfig()
+					SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = readSPConfig()
 					if CLIENTID == "" {
 						setSampleSP()
 						start(domain, userName, password)
-						if utils.ReadFile() == "" {
+						if readAccessToken() == "" {
 							return
 						}
 					} else {
 						start(domain, userName, password)
-						if utils.ReadFile() == "" {
+						if readAccessToken() == "" {
 							return
 						}
 					}
 				}
 			}
 		} else {
-			SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = utils.ReadSPConfig()
+			SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = readSPConfig()
 			if CLIENTID == "" {
 				setSampleSP()
-				SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = utils.ReadSPConfig()
+				SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = readSPConfig()
 				setServerWithInit(SERVER)
-				if utils.ReadFile() == "" {
+				if readAccessToken() == "" {
 					return
 				}
 			} else {
-				token := utils.ReadFile()
+				token := readAccessToken()
 				if token == "" {
 					setServer()
-					if utils.ReadFile() == "" {
+					if readAccessToken() == "" {
 						return
 					}
 				}
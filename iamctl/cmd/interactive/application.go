@@ -25,7 +25,6 @@ import (
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
-	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
 )
 
 var createSPCmd = &cobra.Command{
@@ -100,15 +99,15 @@ func create() {
 		CallbackURLs string `survey:"callbackURls"`
 	}{}
 
-	SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = utils.ReadSPConfig()
+	SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = readSPConfig()
 
 	if CLIENTID == "" {
 		setSampleSP()
-		SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = utils.ReadSPConfig()
+		SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = readSPConfig()
 		setServerWithInit(SERVER)
-	} else if utils.ReadFile() == "" {
+	} else if readAccessToken() == "" {
 		setServer()
-		if utils.ReadFile() == "" {
+		if readAccessToken() == "" {
 			return
 		}
 	}
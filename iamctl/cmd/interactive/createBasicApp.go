@@ -20,13 +20,10 @@ package interactive
 
 import (
 	"bytes"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
-
-	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
 )
 
 type ServiceProvider struct {
@@ -36,13 +33,13 @@ type ServiceProvider struct {
 
 func createSPBasicApplication(spName string, spDescription string) {
 
-	SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = utils.ReadSPConfig()
+	SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = readSPConfig()
 
 	var ADDAPPURL = SERVER + "/t/" + TENANTDOMAIN + "/api/server/v1/applications"
 	var err error
 	var status int
 
-	token := utils.ReadFile()
+	token := readAccessToken()
 
 	toJson := ServiceProvider{spName, spDescription}
 	jsonData, err := json.Marshal(toJson)
@@ -50,8 +47,6 @@ func createSPBasicApplication(spName string, spDescription string) {
 		log.Fatalln(err)
 	}
 
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-
 	req, err := http.NewRequest("POST", ADDAPPURL, bytes.NewBuffer(jsonData))
 	if err != nil {
 		log.Fatalln(err)
@@ -62,7 +57,7 @@ func createSPBasicApplication(spName string, spDescription string) {
 
 	defer req.Body.Close()
 
-	httpClient := &http.Client{}
+	httpClient := newHTTPClient()
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		log.Fatalln(err)
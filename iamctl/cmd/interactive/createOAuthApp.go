@@ -20,7 +20,6 @@ package interactive
 
 import (
 	"bytes"
-	"crypto/tls"
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
@@ -28,8 +27,6 @@ import (
 	"log"
 	"net/http"
 	"strings"
-
-	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
 )
 
 type Parts struct {
@@ -114,14 +111,14 @@ type Export struct {
 
 func createSPOauthApplication(oauthAppName string, description string, callbackURLs string, grantTypes []string) {
 
-	SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = utils.ReadSPConfig()
+	SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = readSPConfig()
 
 	var ADDAPPURL = SERVER + "/t/" + TENANTDOMAIN + "/api/server/v1/applications"
 	var err error
 	var status int
 	var xmlData ServiceProviderXml
 
-	token := utils.ReadFile()
+	token := readAccessToken()
 
 	toJson := ServiceProviderOAuth{
 		Name:        oauthAppName,
@@ -139,8 +136,6 @@ func createSPOauthApplication(oauthAppName string, description string, callbackU
 		log.Fatalln(err)
 	}
 
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-
 	req, err := http.NewRequest("POST", ADDAPPURL, bytes.NewBuffer(jsonData))
 	if err != nil {
 		log.Fatalln(err)
@@ -151,7 +146,7 @@ func createSPOauthApplication(oauthAppName string, description string, callbackU
 
 	defer req.Body.Close()
 
-	httpClient := &http.Client{}
+	httpClient := newHTTPClient()
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		log.Fatalln(err)
@@ -175,8 +170,6 @@ func createSPOauthApplication(oauthAppName string, description string, callbackU
 		splits := strings.SplitAfter(location, "applications/")
 		serviceProviderID := splits[1]
 
-		http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-
 		req, _ := http.NewRequest("GET", ADDAPPURL+"/"+serviceProviderID+"/export", bytes.NewBuffer(nil))
 		query := req.URL.Query()
 		query.Add("exportSecrets", "true")
@@ -186,7 +179,7 @@ func createSPOauthApplication(oauthAppName string, description string, callbackU
 
 		defer req.Body.Close()
 
-		httpClient := &http.Client{}
+		httpClient := newHTTPClient()
 		resp, err := httpClient.Do(req)
 		if err != nil {
 			log.Fatalln(err)
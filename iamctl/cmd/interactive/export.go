@@ -19,7 +19,6 @@
 package interactive
 
 import (
-	"crypto/tls"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -29,7 +28,6 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
-	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
 )
 
 var exportCmd = &cobra.Command{
@@ -94,13 +92,13 @@ func setExportInfo() {
 
 func exportApplication(serviceProviderID string, exportlocation string, fileType string) {
 
-	SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = utils.ReadSPConfig()
+	SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = readSPConfig()
 	setServer()
 
 	var ADDAPPURL = SERVER + "/t/" + TENANTDOMAIN + "/api/server/v1/applications"
 	var err error
 
-	token := utils.ReadFile()
+	token := readAccessToken()
 
 	var reqUrl = ADDAPPURL + "/" + serviceProviderID + "/exportFile"
 	req, err := http.NewRequest("GET", reqUrl, strings.NewReader(""))
@@ -112,13 +110,7 @@ func exportApplication(serviceProviderID string, exportlocation string, fileType
 	req.Header.Set("Authorization", "Bearer "+token)
 	defer req.Body.Close()
 
-	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
+	httpClient := newHTTPClient()
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
@@ -20,7 +20,6 @@ package interactive
 
 import (
 	"bytes"
-	"crypto/tls"
 	"fmt"
 	"io"
 	"log"
@@ -32,7 +31,6 @@ import (
 	"path/filepath"
 
 	"github.com/spf13/cobra"
-	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
 )
 
 var importCmd = &cobra.Command{
@@ -57,12 +55,12 @@ func init() {
 
 func importApplication(importFilePath string) {
 
-	SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = utils.ReadSPConfig()
+	SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = readSPConfig()
 	setServer()
 
 	var ADDAPPURL = SERVER + "/t/" + TENANTDOMAIN + "/api/server/v1/applications/import"
 
-	token := utils.ReadFile()
+	token := readAccessToken()
 
 	file, err := os.Open(importFilePath)
 	if err != nil {
@@ -100,13 +98,7 @@ func importApplication(importFilePath string) {
 	if err != nil {
 		log.Fatal(err)
 	}
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
+	client := newHTTPClient()
 
 	resp, err := client.Do(request)
 	if err != nil {
@@ -20,7 +20,6 @@ package interactive
 
 import (
 	"bytes"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -31,7 +30,6 @@ import (
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
-	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
 )
 
 var getListCmd = &cobra.Command{
@@ -51,7 +49,7 @@ var getListCmd = &cobra.Command{
 				password, _ := cmd.Flags().GetString("password")
 
 				if userName == "" && password == "" {
-					token := utils.ReadFile()
+					token := readAccessToken()
 					if token == "" {
 						fmt.Println("required flag(s) \"password\",\"userName\" not set \nFlags:\n-u, --userName string       Username for Identity Server\n-p, --password string       Password for Identity Server")
 						return
@@ -60,7 +58,7 @@ var getListCmd = &cobra.Command{
 					}
 				} else {
 					if password == "" {
-						token := utils.ReadFile()
+						token := readAccessToken()
 						if token == "" {
 							fmt.Println("required flag(s) \"password\" not set \nFlag:\n-p, --password string       Password for Identity Server ")
 							return
@@ -68,7 +66,7 @@ var getListCmd = &cobra.Command{
 							getList()
 						}
 					} else if userName == "" {
-						token := utils.ReadFile()
+						token := readAccessToken()
 						if token == "" {
 							fmt.Println("required flag(s) \"userName\" not set \nFlag:\n-u, --userName string       Username for Identity Server ")
 							return
@@ -77,18 +75,18 @@ var getListCmd = &cobra.Command{
 						}
 
 					} else {
-						SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = utils.ReadSPConfig()
+						SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = readSPConfig()
 						if CLIENTID == "" {
 							setSampleSP()
 							start(server, userName, password)
-							if utils.ReadFile() == "" {
+							if readAccessToken() == "" {
 								return
 							} else {
 								getList()
 							}
 						} else {
 							start(server, userName, password)
-							if utils.ReadFile() == "" {
+							if readAccessToken() == "" {
 								return
 							} else {
 								getList()
@@ -125,23 +123,21 @@ func init() {
 }
 func getList() {
 
-	SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = utils.ReadSPConfig()
+	SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = readSPConfig()
 
 	var GETLISTURL = SERVER + "/t/" + TENANTDOMAIN + "/api/server/v1/applications"
 	var status int
 	var list List
 	var app Application
 
-	token := utils.ReadFile()
-
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	token := readAccessToken()
 
 	req, _ := http.NewRequest("GET", GETLISTURL, bytes.NewBuffer(nil))
 	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("accept", "*/*")
 	defer req.Body.Close()
 
-	httpClient := &http.Client{}
+	httpClient := newHTTPClient()
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		log.Fatalln(err)
@@ -153,7 +149,7 @@ func getList() {
 	if status == 401 {
 		fmt.Println("Unauthorized access.\nPlease enter your Username and password for server.")
 		setServerWithInit(SERVER)
-		if utils.ReadFile() == "" {
+		if readAccessToken() == "" {
 			return
 		} else {
 			getList()
@@ -19,7 +19,6 @@
 package interactive
 
 import (
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -70,13 +69,15 @@ func start(serverUrl string, userName string, password string) {
 
 	accessToken, refreshToken = sendOAuthRequest(userName, password)
 	if accessToken != "" {
-		utils.WriteFiles(IAMURL, accessToken, refreshToken)
+		if err := utils.WriteFiles(IAMURL, accessToken, refreshToken); err != nil {
+			log.Fatalln(err)
+		}
 	}
 }
 
 func sendOAuthRequest(userName string, password string) (string, string) {
 
-	SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = utils.ReadSPConfig()
+	SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = readSPConfig()
 
 	var err error
 	var accessToken string
@@ -98,13 +99,7 @@ func sendOAuthRequest(userName string, password string) (string, string) {
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	defer req.Body.Close()
 
-	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
+	httpClient := newHTTPClient()
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
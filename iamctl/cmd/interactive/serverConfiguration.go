@@ -25,7 +25,6 @@ import (
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/cmd"
-	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
 )
 
 var configCmd = &cobra.Command{
@@ -39,10 +38,10 @@ var configCmd = &cobra.Command{
 		}
 
 		if server == "" {
-			SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = utils.ReadSPConfig()
+			SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = readSPConfig()
 			if CLIENTID == "" {
 				setSampleSP()
-				SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = utils.ReadSPConfig()
+				SERVER, CLIENTID, CLIENTSECRET, TENANTDOMAIN = readSPConfig()
 				setServerWithInit(SERVER)
 			} else {
 				setServer()
@@ -20,13 +20,14 @@ package interactive
 
 import (
 	"bytes"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
 )
@@ -37,6 +38,45 @@ type ServerInfo struct {
 	Application string `json:"application"`
 }
 
+var tlsConfigOnce sync.Once
+
+// Returns a HTTP client using the TLS configs given as environment variables, since the interactive mode
+// does not use the server config file.
+func newHTTPClient() *http.Client {
+
+	tlsConfigOnce.Do(func() {
+		var serverConfigs utils.ServerConfigs
+		err := utils.LoadTLSConfigsFromEnvVar(&serverConfigs)
+		if err == nil {
+			err = utils.InitTLSConfig(serverConfigs)
+		}
+		if err != nil {
+			log.Fatalln("Error in the TLS configs: ", err)
+		}
+	})
+	return utils.GetHTTPClient()
+}
+
+// Returns the stored access token of the interactive mode. Exits if the access token file cannot be read.
+func readAccessToken() string {
+
+	token, err := utils.ReadFile()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	return token
+}
+
+// Returns the stored server details of the interactive mode. Exits if the server details file cannot be read.
+func readSPConfig() (string, string, string, string) {
+
+	server, clientId, clientSecret, tenantDomain, err := utils.ReadSPConfig()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	return server, clientId, clientSecret, tenantDomain
+}
+
 func createFileIfNotExist(filepath string) {
 
 	if _, err := os.Stat(filepath); os.IsNotExist(err) {
@@ -101,7 +141,6 @@ func callArtifactServiceApi(technology string, application string) *http.Respons
 
 	serverDetails := readServerDetails()
 	token := serverDetails.AccessToken
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
 	artifactServiceUrl := serverDetails.Server + "/artifact-service/service/artifact/" + technology
 
 	toJson := ServerInfo{
@@ -124,7 +163,7 @@ func callArtifactServiceApi(technology string, application string) *http.Respons
 	req.Header.Set("Content-Type", "application/json")
 	defer req.Body.Close()
 
-	httpClient := &http.Client{}
+	httpClient := newHTTPClient()
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		fmt.Println("Error while getting response from artifact-service")
@@ -49,8 +49,12 @@ func Execute() {
 
 func init() {
 
-	utils.CreateFile()
-	utils.CreateSampleSPFile()
+	if err := utils.CreateFile(); err != nil {
+		log.Fatalln(err)
+	}
+	if err := utils.CreateSampleSPFile(); err != nil {
+		log.Fatalln(err)
+	}
 
 	cobra.OnInitialize(initConfig)
 }
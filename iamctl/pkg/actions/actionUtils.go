@@ -179,7 +179,6 @@ func replaceRuleReferences(actionMap map[string]interface{}) error {
 	if !ok {
 		return fmt.Errorf("unexpected format for rules array")
 	}
-	appMap := utils.GetResourceIdentifierMap(utils.APPLICATIONS)
 
 	for _, rule := range rules {
 		ruleMap, ok := rule.(map[string]interface{})
@@ -204,7 +203,7 @@ func replaceRuleReferences(actionMap map[string]interface{}) error {
 			if !ok {
 				return fmt.Errorf("unexpected format for value in expression")
 			}
-			newVal, found := appMap[oldVal]
+			newVal, found := utils.GetResourceIdentifier(utils.APPLICATIONS, oldVal)
 			if !found {
 				return fmt.Errorf("referenced application '%s' has not been successfully handled", oldVal)
 			}
@@ -20,7 +20,6 @@ package actions
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -47,7 +46,7 @@ func ExportAll(outputDirPath, format string) {
 	}
 
 	if _, err := os.Stat(actionsDir); os.IsNotExist(err) {
-		if err := os.MkdirAll(actionsDir, 0700); err != nil {
+		if err := utils.CreateExportDirectory(actionsDir); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.ACTIONS, "", fmt.Sprintf("Error creating actions directory: %s", err))
 			utils.MarkResTypeFailure(utils.ACTIONS)
 			return
@@ -62,7 +61,7 @@ func ExportAll(outputDirPath, format string) {
 
 		hadActions, err := exportActionType(at, actionsDir, format)
 		if err != nil {
-			utils.UpdateFailureSummary(utils.ACTIONS, at.ID)
+			utils.UpdateFailureSummary(utils.ACTIONS, at.ID, err)
 			utils.PrintLog(utils.LogLevelError, utils.ACTIONS, at.ID, fmt.Sprintf("Error exporting action type: %s", err))
 		} else {
 			if hadActions {
@@ -91,7 +90,7 @@ func exportActionType(actionType actionType, parentDir, format string) (bool, er
 	utils.PrintLog(utils.LogLevelInfo, utils.ACTIONS, actionType.ID, "Exporting action type")
 	typeDir := filepath.Join(parentDir, actionType.ID)
 	if _, err := os.Stat(typeDir); os.IsNotExist(err) {
-		if err := os.MkdirAll(typeDir, 0700); err != nil {
+		if err := utils.CreateExportDirectory(typeDir); err != nil {
 			return false, fmt.Errorf("error creating action type directory: %w", err)
 		}
 	} else if utils.TOOL_CONFIGS.AllowDelete {
@@ -138,7 +137,7 @@ func exportAction(typeId, actionId, actionName, outputDir, formatStr string) err
 		return fmt.Errorf("error serializing action: %w", err)
 	}
 
-	if err := ioutil.WriteFile(exportedFileName, modifiedFile, 0644); err != nil {
+	if err := utils.WriteExportedFile(exportedFileName, modifiedFile); err != nil {
 		return fmt.Errorf("error writing exported content to file: %w", err)
 	}
 
@@ -67,8 +67,10 @@ func ImportAll(inputDirPath string) {
 		if !utils.IsResourceExcluded(typeName, utils.TOOL_CONFIGS.ActionConfigs) {
 			err := importActionType(importFilePath, typeName)
 			if err != nil {
-				utils.UpdateFailureSummary(utils.ACTIONS, typeName)
+				utils.UpdateFailureSummary(utils.ACTIONS, typeName, err)
 				utils.PrintLog(utils.LogLevelError, utils.ACTIONS, typeName, fmt.Sprintf("Error importing action type: %s", err))
+			} else {
+				utils.MarkResourceCompleted(utils.ACTIONS, typeName)
 			}
 		}
 	}
@@ -225,7 +227,7 @@ func removeDeletedDeployedActionTypes(localDirs []os.FileInfo, deployedTypes []a
 			continue
 		}
 		if err := removeDeletedDeployedActions(deployedType.ID, nil, actions); err != nil {
-			utils.UpdateFailureSummary(utils.ACTIONS, deployedType.ID)
+			utils.UpdateFailureSummary(utils.ACTIONS, deployedType.ID, err)
 			utils.PrintLog(utils.LogLevelError, utils.ACTIONS, deployedType.ID, fmt.Sprintf("Error deleting actions: %s", err))
 		}
 	}
@@ -187,7 +187,7 @@ func writeScopesMap(outputDirPath string, scopeMap map[string]string, formatStri
 	if err != nil {
 		return fmt.Errorf("error serializing scope name map: %w", err)
 	}
-	if err := ioutil.WriteFile(exportedFileName, data, 0644); err != nil {
+	if err := utils.WriteExportedFile(exportedFileName, data); err != nil {
 		return fmt.Errorf("error writing scope name map: %w", err)
 	}
 	return nil
@@ -196,7 +196,7 @@ func writeScopesMap(outputDirPath string, scopeMap map[string]string, formatStri
 func updateApiResourceExportSummary(success bool, successCount int) {
 
 	if !success {
-		utils.UpdateFailureSummary(utils.API_RESOURCES, utils.API_RESOURCE_SCOPES.String())
+		utils.UpdateFailureSummary(utils.API_RESOURCES, utils.API_RESOURCE_SCOPES.String(), nil)
 		return
 	}
 	for i := 0; i < successCount; i++ {
@@ -20,7 +20,6 @@ package apiResources
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -43,7 +42,7 @@ func ExportAll(exportFilePath string, format string) {
 	}
 
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.API_RESOURCES, "", fmt.Sprintf("Error creating API resources directory: %s", err))
 			utils.MarkResTypeFailure(utils.API_RESOURCES)
 			return
@@ -63,7 +62,7 @@ func ExportAll(exportFilePath string, format string) {
 			utils.PrintLog(utils.LogLevelInfo, utils.API_RESOURCES, resource.Identifier, "Exporting")
 			err := exportApiResource(resource.ID, resource.Identifier, exportFilePath, format)
 			if err != nil {
-				utils.UpdateFailureSummary(utils.API_RESOURCES, resource.Identifier)
+				utils.UpdateFailureSummary(utils.API_RESOURCES, resource.Identifier, err)
 				utils.PrintLog(utils.LogLevelError, utils.API_RESOURCES, resource.Identifier, fmt.Sprintf("Error while exporting: %s", err))
 			} else {
 				successCount++
@@ -110,7 +109,7 @@ func exportApiResource(resourceId string, resourceIdentifier string, outputDirPa
 		return fmt.Errorf("error while serializing API resource: %w", err)
 	}
 
-	if err := ioutil.WriteFile(exportedFileName, modifiedFile, 0644); err != nil {
+	if err := utils.WriteExportedFile(exportedFileName, modifiedFile); err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
 
@@ -61,7 +61,7 @@ func ImportAll(inputDirPath string) {
 	if err != nil {
 		utils.PrintLog(utils.LogLevelError, utils.API_RESOURCES, "", fmt.Sprintf("Error reading local scope name map: %s", err))
 		utils.MarkResTypeFailure(utils.API_RESOURCES)
-		utils.UpdateFailureSummary(utils.API_RESOURCES, utils.API_RESOURCE_SCOPES.String())
+		utils.UpdateFailureSummary(utils.API_RESOURCES, utils.API_RESOURCE_SCOPES.String(), err)
 		return
 	}
 	failedResources := removeDeletedDeployedScopes(localScopeMap, deployedResources)
@@ -76,14 +76,16 @@ func ImportAll(inputDirPath string) {
 		}
 		if _, failed := failedResources[resourceName]; failed {
 			utils.PrintLog(utils.LogLevelInfo, utils.API_RESOURCES, resourceName, "Skipping: deleting stale scopes failed")
-			utils.UpdateFailureSummary(utils.API_RESOURCES, resourceName)
+			utils.UpdateFailureSummary(utils.API_RESOURCES, resourceName, nil)
 			continue
 		}
 		if !utils.IsResourceExcluded(resourceName, utils.TOOL_CONFIGS.ApiResourceConfigs) {
 			resourceId := getApiResourceId(resourceName, deployedResources)
 			if err := importApiResource(resourceId, resourceName, apiResFilePath); err != nil {
 				utils.PrintLog(utils.LogLevelError, utils.API_RESOURCES, resourceName, fmt.Sprintf("Error importing API resource: %s", err))
-				utils.UpdateFailureSummary(utils.API_RESOURCES, resourceName)
+				utils.UpdateFailureSummary(utils.API_RESOURCES, resourceName, err)
+			} else {
+				utils.MarkResourceCompleted(utils.API_RESOURCES, resourceName)
 			}
 		}
 	}
@@ -196,7 +198,7 @@ func removeDeletedDeployedApiResources(localFiles []os.FileInfo, deployedResourc
 
 		utils.PrintLog(utils.LogLevelInfo, utils.API_RESOURCES, resource.Identifier, "Not found locally. Deleting.")
 		if err := utils.SendDeleteRequest(resource.ID, utils.API_RESOURCES); err != nil {
-			utils.UpdateFailureSummary(utils.API_RESOURCES, resource.Identifier)
+			utils.UpdateFailureSummary(utils.API_RESOURCES, resource.Identifier, err)
 			utils.PrintLog(utils.LogLevelError, utils.API_RESOURCES, resource.Identifier, fmt.Sprintf("Error deleting API resource: %s", err))
 			remainingResources = append(remainingResources, resource)
 		} else {
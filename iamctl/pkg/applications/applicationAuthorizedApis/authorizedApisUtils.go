@@ -226,8 +226,7 @@ func validateAuthorizedApiReferences(apiData interface{}) error {
 func validateApiResourceRef(reference, apiType string) error {
 
 	if apiType == "BUSINESS" {
-		resourceMap := utils.GetResourceIdentifierMap(utils.API_RESOURCES)
-		if _, ok := resourceMap[reference]; !ok {
+		if _, ok := utils.GetResourceIdentifier(utils.API_RESOURCES, reference); !ok {
 			return fmt.Errorf("referenced API resource with identifier '%s' has not been successfully handled", reference)
 		}
 	}
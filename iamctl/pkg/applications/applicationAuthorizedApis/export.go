@@ -20,7 +20,6 @@ package applicationAuthorizedApis
 
 import (
 	"fmt"
-	"io/ioutil"
 
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
 )
@@ -54,7 +53,7 @@ func ExportAPIs(appId, appName, appsOutputDirPath, formatString string) error {
 		return fmt.Errorf("error serializing authorized APIs: %w", err)
 	}
 
-	if err := ioutil.WriteFile(exportedFileName, fileContent, 0644); err != nil {
+	if err := utils.WriteExportedFile(exportedFileName, fileContent); err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
 
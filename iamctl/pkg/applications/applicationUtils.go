@@ -539,3 +539,17 @@ func InitDeployedRoleIds() error {
 	}
 	return nil
 }
+
+// Registers the identifiers of the deployed applications, so that the references to them can be resolved
+// when the applications are not processed in the same run.
+func RegisterDeployedIdentifiers(operation string) error {
+
+	apps, err := getAppList()
+	if err != nil {
+		return err
+	}
+	for _, app := range apps {
+		utils.AddToIdentifierMap(utils.APPLICATIONS, app.Id, app.Name, operation)
+	}
+	return nil
+}
@@ -52,7 +52,7 @@ func ExportAll(exportFilePath string, format string) {
 	deployedAppNames := getDeployedAppNames(apps)
 
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.APPLICATIONS, "", fmt.Sprintf("Error creating applications directory: %s", err))
 			utils.MarkResTypeFailure(utils.APPLICATIONS)
 			return
@@ -65,7 +65,7 @@ func ExportAll(exportFilePath string, format string) {
 
 	if applicationAuthorizedApis.IsSupported {
 		if _, err := os.Stat(authAPIsOutputDir); os.IsNotExist(err) {
-			if err := os.MkdirAll(authAPIsOutputDir, 0700); err != nil {
+			if err := utils.CreateExportDirectory(authAPIsOutputDir); err != nil {
 				utils.PrintLog(utils.LogLevelError, utils.APPLICATIONS, "", fmt.Sprintf("Error creating authorized APIs directory: %s", err))
 				utils.MarkResTypeFailure(utils.APPLICATIONS)
 				return
@@ -77,7 +77,8 @@ func ExportAll(exportFilePath string, format string) {
 		}
 	}
 	excludeSecrets := utils.AreSecretsExcluded(utils.TOOL_CONFIGS.ApplicationConfigs)
-	for _, app := range apps {
+	utils.ProcessResources(len(apps), func(i int) {
+		app := apps[i]
 		if !utils.IsResourceExcluded(app.Name, utils.TOOL_CONFIGS.ApplicationConfigs) {
 			utils.PrintLog(utils.LogLevelInfo, utils.APPLICATIONS, app.Name, "Exporting")
 			var err error
@@ -87,7 +88,7 @@ func ExportAll(exportFilePath string, format string) {
 				err = exportAppWithCRUD(app.Id, app.Name, exportFilePath, format, excludeSecrets)
 			}
 			if err != nil {
-				utils.UpdateFailureSummary(utils.APPLICATIONS, app.Name)
+				utils.UpdateFailureSummary(utils.APPLICATIONS, app.Name, err)
 				utils.PrintLog(utils.LogLevelError, utils.APPLICATIONS, app.Name, fmt.Sprintf("Error while exporting: %s", err))
 			} else {
 				utils.AddToIdentifierMap(utils.APPLICATIONS, app.Id, app.Name, utils.EXPORT)
@@ -95,11 +96,11 @@ func ExportAll(exportFilePath string, format string) {
 				utils.PrintLog(utils.LogLevelInfo, utils.APPLICATIONS, app.Name, "Exported successfully")
 			}
 		}
-	}
+	})
 
 	if !utils.IsResourceExcluded(utils.RESIDENT_APP, utils.TOOL_CONFIGS.ApplicationConfigs) {
 		if err := exportResidentApp(exportFilePath, format); err != nil {
-			utils.UpdateFailureSummary(utils.APPLICATIONS, utils.RESIDENT_APP)
+			utils.UpdateFailureSummary(utils.APPLICATIONS, utils.RESIDENT_APP, err)
 			utils.PrintLog(utils.LogLevelError, utils.APPLICATIONS, utils.RESIDENT_APP, fmt.Sprintf("Error while exporting resident application: %s", err))
 		} else {
 			utils.UpdateSuccessSummary(utils.APPLICATIONS, utils.EXPORT)
@@ -127,12 +128,12 @@ func exportApp(appId string, outputDirPath string, format string, excludeSecrets
 
 	resp, err := utils.SendExportRequest(appId, fileType, utils.APPLICATIONS, excludeSecrets)
 	if err != nil {
-		return fmt.Errorf("error while exporting the application: %s", err)
+		return fmt.Errorf("error while exporting the application: %w", err)
 	}
 	var attachmentDetail = resp.Header.Get("Content-Disposition")
 	_, params, err := mime.ParseMediaType(attachmentDetail)
 	if err != nil {
-		return fmt.Errorf("error while parsing the content disposition header: %s", err)
+		return fmt.Errorf("error while parsing the content disposition header: %w", err)
 	}
 
 	fileName := params["filename"]
@@ -150,10 +151,10 @@ func exportApp(appId string, outputDirPath string, format string, excludeSecrets
 	appKeywordMapping := getAppKeywordMapping(fileInfo.ResourceName)
 	modifiedFile, err := utils.ProcessExportedContent(exportedFileName, body, appKeywordMapping, utils.APPLICATIONS)
 	if err != nil {
-		return fmt.Errorf("error while processing exported data: %s", err)
+		return fmt.Errorf("error while processing exported data: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing the exported content to file: %w", err)
 	}
@@ -184,7 +185,7 @@ func exportAppWithCRUD(appId, appName, outputDirPath, formatString string, exclu
 		return fmt.Errorf("error while serializing application: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
@@ -218,7 +219,7 @@ func exportResidentApp(outputDirPath, formatString string) error {
 		return fmt.Errorf("error while serializing application: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
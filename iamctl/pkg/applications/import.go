@@ -75,9 +75,10 @@ func ImportAll(inputDirPath string) {
 		removeDeletedDeployedApps(files, deployedApps)
 	}
 
-	for _, file := range files {
+	utils.ProcessResources(len(files), func(i int) {
+		file := files[i]
 		if file.IsDir() {
-			continue
+			return
 		}
 		appFilePath := filepath.Join(importFilePath, file.Name())
 		fileInfo := utils.GetFileInfo(appFilePath)
@@ -88,10 +89,12 @@ func ImportAll(inputDirPath string) {
 			err := importApp(appId, appName, appFilePath, exportAPIExists)
 			if err != nil {
 				utils.PrintLog(utils.LogLevelError, utils.APPLICATIONS, appName, fmt.Sprintf("Error importing application: %s", err))
-				utils.UpdateFailureSummary(utils.APPLICATIONS, appName)
+				utils.UpdateFailureSummary(utils.APPLICATIONS, appName, err)
+			} else {
+				utils.MarkResourceCompleted(utils.APPLICATIONS, appName)
 			}
 		}
-	}
+	})
 
 	if utils.IsResourceTypeExcluded(utils.ROLES) {
 		utils.PrintLog(utils.LogLevelWarn, utils.APPLICATIONS, "", "Roles are excluded from import. Import Roles to propagate new application roles.")
@@ -107,7 +110,7 @@ func importApp(appId, appName, importFilePath string, exportAPIExists bool) erro
 
 	fileBytes, err := ioutil.ReadFile(importFilePath)
 	if err != nil {
-		return fmt.Errorf("error when reading the file for application: %s", err)
+		return fmt.Errorf("error when reading the file for application: %w", err)
 	}
 
 	appKeywordMapping := getAppKeywordMapping(appName)
@@ -163,7 +166,7 @@ func importApplication(appName, importFilePath, modifiedFileData string, format
 	utils.PrintLog(utils.LogLevelInfo, utils.APPLICATIONS, appName, "Creating new application")
 	resp, err := utils.SendImportRequest(importFilePath, modifiedFileData, utils.APPLICATIONS)
 	if err != nil {
-		return "", fmt.Errorf("error when importing application: %s", err)
+		return "", fmt.Errorf("error when importing application: %w", err)
 	}
 	defer resp.Body.Close()
 
@@ -198,7 +201,7 @@ func updateApplication(appId, appName, importFilePath, modifiedFileData string,
 
 	err = utils.SendUpdateRequest(appId, importFilePath, fileData, utils.APPLICATIONS)
 	if err != nil {
-		return fmt.Errorf("error when updating application: %s", err)
+		return fmt.Errorf("error when updating application: %w", err)
 	}
 
 	utils.AddToIdentifierMap(utils.APPLICATIONS, appId, appName, utils.IMPORT)
@@ -398,7 +401,7 @@ func removeDeletedDeployedApps(localFiles []os.FileInfo, deployedApps []Applicat
 		utils.PrintLog(utils.LogLevelInfo, utils.APPLICATIONS, app.Name, "Not found locally. Deleting app.")
 		err := utils.SendDeleteRequest(app.Id, utils.APPLICATIONS)
 		if err != nil {
-			utils.UpdateFailureSummary(utils.APPLICATIONS, app.Name)
+			utils.UpdateFailureSummary(utils.APPLICATIONS, app.Name, err)
 			utils.PrintLog(utils.LogLevelError, utils.APPLICATIONS, app.Name, fmt.Sprintf("Error deleting application: %s", err))
 		}
 		utils.UpdateSuccessSummary(utils.APPLICATIONS, utils.DELETE)
@@ -20,7 +20,6 @@ package brandingPreferences
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -36,7 +35,7 @@ func ExportAll(parentDir string, formatString string) {
 		return
 	}
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.BRANDING_PREFERENCES, "", fmt.Sprintf("Error creating branding preferences directory: %s", err))
 			utils.MarkResTypeFailure(utils.BRANDING_PREFERENCES)
 			return
@@ -52,7 +51,7 @@ func ExportAll(parentDir string, formatString string) {
 			}
 			return
 		}
-		utils.UpdateFailureSummary(utils.BRANDING_PREFERENCES, resourceFileName)
+		utils.UpdateFailureSummary(utils.BRANDING_PREFERENCES, resourceFileName, err)
 		utils.PrintLog(utils.LogLevelError, utils.BRANDING_PREFERENCES, "", fmt.Sprintf("Error while exporting branding preferences: %s", err))
 	} else {
 		utils.UpdateSuccessSummary(utils.BRANDING_PREFERENCES, utils.EXPORT)
@@ -81,7 +80,7 @@ func exportBrandingPreferences(outputDirPath string, formatString string) error
 		return fmt.Errorf("error while serializing exported content: %w", err)
 	}
 
-	if err := ioutil.WriteFile(exportedFileName, modifiedFile, 0644); err != nil {
+	if err := utils.WriteExportedFile(exportedFileName, modifiedFile); err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
 
@@ -60,7 +60,7 @@ func ImportAll(parentDir string) {
 
 	err = importBrandingPreferences(filePath, isDeployed)
 	if err != nil {
-		utils.UpdateFailureSummary(utils.BRANDING_PREFERENCES, resourceFileName)
+		utils.UpdateFailureSummary(utils.BRANDING_PREFERENCES, resourceFileName, err)
 		utils.PrintLog(utils.LogLevelError, utils.BRANDING_PREFERENCES, "", fmt.Sprintf("Error while importing branding preferences: %s", err))
 	}
 }
@@ -125,7 +125,7 @@ func removeDeletedDeployedBrandingPreferences() {
 	utils.PrintLog(utils.LogLevelInfo, utils.BRANDING_PREFERENCES, "", "Not found locally. Deleting preferences.")
 
 	if err := utils.SendDeleteRequest("", utils.BRANDING_PREFERENCES); err != nil {
-		utils.UpdateFailureSummary(utils.BRANDING_PREFERENCES, resourceFileName)
+		utils.UpdateFailureSummary(utils.BRANDING_PREFERENCES, resourceFileName, err)
 		utils.PrintLog(utils.LogLevelError, utils.BRANDING_PREFERENCES, "", fmt.Sprintf("Error while deleting branding preferences: %s", err))
 	} else {
 		utils.UpdateSuccessSummary(utils.BRANDING_PREFERENCES, utils.DELETE)
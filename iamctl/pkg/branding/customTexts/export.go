@@ -20,7 +20,6 @@ package customTexts
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -36,7 +35,7 @@ func ExportAll(parentDir string, formatString string) {
 		return
 	}
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.CUSTOM_TEXTS, "", fmt.Sprintf("Error creating custom texts directory: %s", err))
 			utils.MarkResTypeFailure(utils.CUSTOM_TEXTS)
 			return
@@ -52,7 +51,7 @@ func ExportAll(parentDir string, formatString string) {
 		hadLocales, err := exportCustomTextScreen(screen, exportFilePath, formatString)
 
 		if err != nil {
-			utils.UpdateFailureSummary(utils.CUSTOM_TEXTS, screen)
+			utils.UpdateFailureSummary(utils.CUSTOM_TEXTS, screen, err)
 			utils.PrintLog(utils.LogLevelError, utils.CUSTOM_TEXTS, screen, fmt.Sprintf("Error while exporting: %s", err))
 		} else {
 			if hadLocales {
@@ -89,7 +88,7 @@ func exportCustomTextScreen(screen, exportFilePath, formatString string) (hadLoc
 		}
 
 		if !screenDirCreated {
-			if err := os.MkdirAll(screenDir, 0700); err != nil {
+			if err := utils.CreateExportDirectory(screenDir); err != nil {
 				return false, fmt.Errorf("error creating directory for screen: %w", err)
 			}
 			screenDirCreated = true
@@ -130,7 +129,7 @@ func exportCustomTextLocale(data interface{}, screenDir, locale string, format u
 	if err != nil {
 		return fmt.Errorf("error while serializing exported content: %w", err)
 	}
-	if err := ioutil.WriteFile(exportedFileName, modifiedFile, 0644); err != nil {
+	if err := utils.WriteExportedFile(exportedFileName, modifiedFile); err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
 	return nil
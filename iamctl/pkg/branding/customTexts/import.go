@@ -66,8 +66,10 @@ func ImportAll(parentDir string) {
 
 		if !utils.IsResourceExcluded(screen, utils.TOOL_CONFIGS.CustomTextConfigs) {
 			if err := importCustomTextScreen(screen, screenDir, deployedTexts[screen]); err != nil {
-				utils.UpdateFailureSummary(utils.CUSTOM_TEXTS, screen)
+				utils.UpdateFailureSummary(utils.CUSTOM_TEXTS, screen, err)
 				utils.PrintLog(utils.LogLevelError, utils.CUSTOM_TEXTS, screen, fmt.Sprintf("Error while importing: %s", err))
+			} else {
+				utils.MarkResourceCompleted(utils.CUSTOM_TEXTS, screen)
 			}
 		}
 	}
@@ -186,7 +188,7 @@ func removeDeletedDeployedScreens(localScreenDirs []os.FileInfo, deployedTexts m
 		for locale := range locales {
 			if err := deleteCustomText(screen, locale); err != nil {
 				utils.PrintLog(utils.LogLevelError, utils.CUSTOM_TEXTS, screen, fmt.Sprintf("Error deleting locale %s: %s", locale, err))
-				utils.UpdateFailureSummary(utils.CUSTOM_TEXTS, screen+"/"+locale)
+				utils.UpdateFailureSummary(utils.CUSTOM_TEXTS, screen+"/"+locale, err)
 				continue
 			} else {
 				utils.UpdateSuccessSummary(utils.CUSTOM_TEXTS, utils.DELETE)
@@ -20,7 +20,6 @@ package certificates
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -36,7 +35,7 @@ func ExportAll(exportFilePath string, format string) {
 		return
 	}
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.CERTIFICATES, "", fmt.Sprintf("Error creating certificates directory: %s", err))
 			utils.MarkResTypeFailure(utils.CERTIFICATES)
 			return
@@ -59,7 +58,7 @@ func ExportAll(exportFilePath string, format string) {
 
 				err := exportCertificate(cert.Alias, exportFilePath, format)
 				if err != nil {
-					utils.UpdateFailureSummary(utils.CERTIFICATES, cert.Alias)
+					utils.UpdateFailureSummary(utils.CERTIFICATES, cert.Alias, err)
 					utils.PrintLog(utils.LogLevelError, utils.CERTIFICATES, cert.Alias, fmt.Sprintf("Error while exporting: %s", err))
 				} else {
 					utils.UpdateSuccessSummary(utils.CERTIFICATES, utils.EXPORT)
@@ -91,7 +90,7 @@ func exportCertificate(alias string, outputDirPath string, formatString string)
 		return fmt.Errorf("error while serializing certificate: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
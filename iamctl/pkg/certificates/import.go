@@ -74,7 +74,9 @@ func ImportAll(inputDirPath string) {
 			err := importCertificate(alias, certExists, certFilePath)
 			if err != nil {
 				utils.PrintLog(utils.LogLevelError, utils.CERTIFICATES, alias, fmt.Sprintf("Error importing certificate: %s", err))
-				utils.UpdateFailureSummary(utils.CERTIFICATES, alias)
+				utils.UpdateFailureSummary(utils.CERTIFICATES, alias, err)
+			} else {
+				utils.MarkResourceCompleted(utils.CERTIFICATES, alias)
 			}
 		}
 	}
@@ -146,7 +148,7 @@ func removeDeletedDeployedCertificates(localFiles []os.FileInfo, deployedCerts [
 
 		utils.PrintLog(utils.LogLevelInfo, utils.CERTIFICATES, cert.Alias, "Not found locally. Deleting.")
 		if err := utils.SendDeleteRequest(cert.Alias, utils.CERTIFICATES); err != nil {
-			utils.UpdateFailureSummary(utils.CERTIFICATES, cert.Alias)
+			utils.UpdateFailureSummary(utils.CERTIFICATES, cert.Alias, err)
 			utils.PrintLog(utils.LogLevelError, utils.CERTIFICATES, cert.Alias, fmt.Sprintf("Error deleting certificate: %s", err))
 		} else {
 			utils.UpdateSuccessSummary(utils.CERTIFICATES, utils.DELETE)
@@ -20,7 +20,6 @@ package challengeQuestions
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -36,7 +35,7 @@ func ExportAll(exportFilePath string, format string) {
 		return
 	}
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.CHALLENGE_QUESTIONS, "", fmt.Sprintf("Error creating challenge questions directory: %s", err))
 			utils.MarkResTypeFailure(utils.CHALLENGE_QUESTIONS)
 			return
@@ -60,7 +59,7 @@ func ExportAll(exportFilePath string, format string) {
 			utils.PrintLog(utils.LogLevelInfo, utils.CHALLENGE_QUESTIONS, set.QuestionSetId, "Exporting")
 			err := exportChallengeSet(set.QuestionSetId, exportFilePath, format)
 			if err != nil {
-				utils.UpdateFailureSummary(utils.CHALLENGE_QUESTIONS, set.QuestionSetId)
+				utils.UpdateFailureSummary(utils.CHALLENGE_QUESTIONS, set.QuestionSetId, err)
 				utils.PrintLog(utils.LogLevelError, utils.CHALLENGE_QUESTIONS, set.QuestionSetId, fmt.Sprintf("Error while exporting: %s", err))
 			} else {
 				utils.UpdateSuccessSummary(utils.CHALLENGE_QUESTIONS, utils.EXPORT)
@@ -91,7 +90,7 @@ func exportChallengeSet(setId string, outputDirPath string, formatString string)
 		return fmt.Errorf("error while serializing challenge question set: %w", err)
 	}
 
-	if err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644); err != nil {
+	if err = utils.WriteExportedFile(exportedFileName, modifiedFile); err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
 
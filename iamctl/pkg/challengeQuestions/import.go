@@ -67,7 +67,9 @@ func ImportAll(inputDirPath string) {
 			err := importChallengeSet(setId, setExists, setFilePath)
 			if err != nil {
 				utils.PrintLog(utils.LogLevelError, utils.CHALLENGE_QUESTIONS, setId, fmt.Sprintf("Error importing challenge question set: %s", err))
-				utils.UpdateFailureSummary(utils.CHALLENGE_QUESTIONS, setId)
+				utils.UpdateFailureSummary(utils.CHALLENGE_QUESTIONS, setId, err)
+			} else {
+				utils.MarkResourceCompleted(utils.CHALLENGE_QUESTIONS, setId)
 			}
 		}
 	}
@@ -161,7 +163,7 @@ func removeDeletedDeployedChallengeSets(localFiles []os.FileInfo, deployedSets [
 
 		utils.PrintLog(utils.LogLevelInfo, utils.CHALLENGE_QUESTIONS, set.QuestionSetId, "Not found locally. Deleting.")
 		if err := utils.SendDeleteRequest(set.QuestionSetId, utils.CHALLENGE_QUESTIONS); err != nil {
-			utils.UpdateFailureSummary(utils.CHALLENGE_QUESTIONS, set.QuestionSetId)
+			utils.UpdateFailureSummary(utils.CHALLENGE_QUESTIONS, set.QuestionSetId, err)
 			utils.PrintLog(utils.LogLevelError, utils.CHALLENGE_QUESTIONS, set.QuestionSetId, fmt.Sprintf("Error deleting challenge question set: %s", err))
 		} else {
 			utils.UpdateSuccessSummary(utils.CHALLENGE_QUESTIONS, utils.DELETE)
@@ -217,7 +217,7 @@ func removeStaleClaimsFromLocalDialect() {
 
 	if err := removeDeletedDeployedClaims(utils.LOCAL_CLAIM_DIALECT, localClaimDialectSummary.DeployedClaims, localClaimDialectSummary.LocalClaims); err != nil {
 		utils.PrintLog(utils.LogLevelError, utils.CLAIMS, utils.LOCAL_CLAIM_DIALECT, fmt.Sprintf("Error removing deleted local claims: %s", err))
-		utils.UpdateFailureSummary(utils.CLAIMS, localClaimDialectSummary.DialectURI)
+		utils.UpdateFailureSummary(utils.CLAIMS, localClaimDialectSummary.DialectURI, err)
 		return
 	}
 	utils.UpdateSuccessSummary(utils.CLAIMS, utils.UPDATE)
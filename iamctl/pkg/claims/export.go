@@ -40,7 +40,7 @@ func ExportAll(exportFilePath string, format string) {
 
 	claimDialects, err := getClaimDialectsList()
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.CLAIMS, "", fmt.Sprintf("Error creating claims directory: %s", err))
 			utils.MarkResTypeFailure(utils.CLAIMS)
 			return
@@ -68,7 +68,7 @@ func ExportAll(exportFilePath string, format string) {
 				}
 
 				if err != nil {
-					utils.UpdateFailureSummary(utils.CLAIMS, dialect.DialectURI)
+					utils.UpdateFailureSummary(utils.CLAIMS, dialect.DialectURI, err)
 					utils.PrintLog(utils.LogLevelError, utils.CLAIMS, dialect.DialectURI, fmt.Sprintf("Error while exporting: %s", err))
 				} else {
 					utils.UpdateSuccessSummary(utils.CLAIMS, utils.EXPORT)
@@ -94,13 +94,13 @@ func exportClaimDialect(dialectId, dialectUri, outputDirPath, format string) err
 
 	resp, err := utils.SendExportRequest(dialectId, fileType, utils.CLAIMS, true)
 	if err != nil {
-		return fmt.Errorf("error while exporting the claim dialect: %s", err)
+		return fmt.Errorf("error while exporting the claim dialect: %w", err)
 	}
 
 	var attachmentDetail = resp.Header.Get("Content-Disposition")
 	_, params, err := mime.ParseMediaType(attachmentDetail)
 	if err != nil {
-		return fmt.Errorf("error while parsing the content disposition header: %s", err)
+		return fmt.Errorf("error while parsing the content disposition header: %w", err)
 	}
 
 	fileName := params["filename"]
@@ -114,10 +114,10 @@ func exportClaimDialect(dialectId, dialectUri, outputDirPath, format string) err
 	claimDialectKeywordMapping := getClaimKeywordMapping(dialectUri)
 	modifiedFile, err := utils.ProcessExportedContent(exportedFileName, body, claimDialectKeywordMapping, utils.CLAIMS)
 	if err != nil {
-		return fmt.Errorf("error while processing the exported content: %s", err)
+		return fmt.Errorf("error while processing the exported content: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing the exported content to file: %w", err)
 	}
@@ -146,7 +146,7 @@ func exportClaimDialectWithCRUD(dialectId, dialectUri, outputDirPath, formatStri
 		return fmt.Errorf("error while serializing claim dialect: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
@@ -83,7 +83,9 @@ func ImportAll(inputDirPath string) {
 			err = importClaimDialect(dialectId, dialectUri, claimFilePath)
 			if err != nil {
 				utils.PrintLog(utils.LogLevelError, utils.CLAIMS, dialectUri, fmt.Sprintf("Error importing claim dialect: %s", err))
-				utils.UpdateFailureSummary(utils.CLAIMS, dialectUri)
+				utils.UpdateFailureSummary(utils.CLAIMS, dialectUri, err)
+			} else {
+				utils.MarkResourceCompleted(utils.CLAIMS, dialectUri)
 			}
 		}
 	}
@@ -94,7 +96,7 @@ func importClaimDialect(dialectId, dialectUri, importFilePath string) error {
 
 	fileBytes, err := ioutil.ReadFile(importFilePath)
 	if err != nil {
-		return fmt.Errorf("error when reading the file for claim dialect: %s", err)
+		return fmt.Errorf("error when reading the file for claim dialect: %w", err)
 	}
 
 	// Replace keyword placeholders in the local file according to the keyword mappings added in configs.
@@ -130,7 +132,7 @@ func importDialect(dialectUri, importFilePath, modifiedFileData string) error {
 	utils.PrintLog(utils.LogLevelInfo, utils.CLAIMS, dialectUri, "Creating new claim dialect")
 	resp, err := utils.SendImportRequest(importFilePath, modifiedFileData, utils.CLAIMS)
 	if err != nil {
-		return fmt.Errorf("error when importing claim dialect: %s", err)
+		return fmt.Errorf("error when importing claim dialect: %w", err)
 	}
 	defer resp.Body.Close()
 	utils.UpdateSuccessSummary(utils.CLAIMS, utils.IMPORT)
@@ -143,7 +145,7 @@ func updateDialect(dialectId, dialectUri, importFilePath, modifiedFileData strin
 	utils.PrintLog(utils.LogLevelInfo, utils.CLAIMS, dialectUri, "Updating claim dialect")
 	err := utils.SendUpdateRequest(dialectId, importFilePath, modifiedFileData, utils.CLAIMS)
 	if err != nil {
-		return fmt.Errorf("error when updating claim dialect: %s", err)
+		return fmt.Errorf("error when updating claim dialect: %w", err)
 	}
 	utils.UpdateSuccessSummary(utils.CLAIMS, utils.UPDATE)
 	utils.PrintLog(utils.LogLevelInfo, utils.CLAIMS, dialectUri, "Updated successfully")
@@ -299,7 +301,7 @@ func removeDeletedDeployedClaimdialect(localFiles []os.FileInfo, deployedClaimDi
 		}
 		utils.PrintLog(utils.LogLevelInfo, utils.CLAIMS, claimDialect.DialectURI, "Not found locally. Deleting.")
 		if err := utils.SendDeleteRequest(claimDialect.Id, utils.CLAIMS); err != nil {
-			utils.UpdateFailureSummary(utils.CLAIMS, claimDialect.DialectURI)
+			utils.UpdateFailureSummary(utils.CLAIMS, claimDialect.DialectURI, err)
 			utils.PrintLog(utils.LogLevelError, utils.CLAIMS, claimDialect.DialectURI, fmt.Sprintf("Error deleting claim dialect: %s", err))
 		} else {
 			utils.UpdateSuccessSummary(utils.CLAIMS, utils.DELETE)
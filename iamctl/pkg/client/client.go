@@ -0,0 +1,253 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package client
+
+import (
+	"fmt"
+
+	actions "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/actions"
+	apiResources "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/apiResources"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/applications"
+	branding "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/branding"
+	certificates "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/certificates"
+	challengeQuestions "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/challengeQuestions"
+	claims "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/claims"
+	emailTemplates "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/emailTemplates"
+	flows "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/flows"
+	governanceConnectors "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/governanceConnectors"
+	identityproviders "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/identityProviders"
+	notificationProviders "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/notificationProviders"
+	notificationTemplates "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/notificationTemplates"
+	oidcScopes "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/oidcScopes"
+	organizations "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/organizations"
+	roles "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/roles"
+	scriptLibraries "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/scriptLibraries"
+	userstores "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/userStores"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+	validationRules "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/validationRules"
+	workflows "github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/workflows"
+)
+
+// Export functions of each resource type. Shared by all the commands that export resources.
+var exportFunctions = map[utils.ResourceType]func(string, string){
+	utils.CLAIMS:                claims.ExportAll,
+	utils.IDENTITY_PROVIDERS:    identityproviders.ExportAll,
+	utils.APPLICATIONS:          applications.ExportAll,
+	utils.USERSTORES:            userstores.ExportAll,
+	utils.OIDC_SCOPES:           oidcScopes.ExportAll,
+	utils.ROLES:                 roles.ExportAll,
+	utils.CHALLENGE_QUESTIONS:   challengeQuestions.ExportAll,
+	utils.EMAIL_TEMPLATES:       emailTemplates.ExportAll,
+	utils.SCRIPT_LIBRARIES:      scriptLibraries.ExportAll,
+	utils.GOVERNANCE_CONNECTORS: governanceConnectors.ExportAll,
+	utils.CERTIFICATES:          certificates.ExportAll,
+	utils.WORKFLOWS:             workflows.ExportAll,
+	utils.API_RESOURCES:         apiResources.ExportAll,
+	utils.VALIDATION_RULES:      validationRules.ExportAll,
+	utils.EMAIL_PROVIDERS:       notificationProviders.ExportAllEmailProviders,
+	utils.SMS_PROVIDERS:         notificationProviders.ExportAllSmsProviders,
+	utils.SMS_TEMPLATES:         notificationTemplates.ExportAllSmsTemplates,
+	utils.ACTIONS:               actions.ExportAll,
+	utils.ORGANIZATIONS:         organizations.ExportAll,
+	utils.BRANDING:              branding.ExportAll,
+	utils.FLOWS:                 flows.ExportAll,
+}
+
+// Import functions of each resource type. Shared by all the commands that import resources.
+var importFunctions = map[utils.ResourceType]func(string){
+	utils.CLAIMS:                claims.ImportAll,
+	utils.IDENTITY_PROVIDERS:    identityproviders.ImportAll,
+	utils.APPLICATIONS:          applications.ImportAll,
+	utils.USERSTORES:            userstores.ImportAll,
+	utils.OIDC_SCOPES:           oidcScopes.ImportAll,
+	utils.ROLES:                 roles.ImportAll,
+	utils.CHALLENGE_QUESTIONS:   challengeQuestions.ImportAll,
+	utils.EMAIL_TEMPLATES:       emailTemplates.ImportAll,
+	utils.SCRIPT_LIBRARIES:      scriptLibraries.ImportAll,
+	utils.GOVERNANCE_CONNECTORS: governanceConnectors.ImportAll,
+	utils.CERTIFICATES:          certificates.ImportAll,
+	utils.WORKFLOWS:             workflows.ImportAll,
+	utils.API_RESOURCES:         apiResources.ImportAll,
+	utils.VALIDATION_RULES:      validationRules.ImportAll,
+	utils.EMAIL_PROVIDERS:       notificationProviders.ImportAllEmailProviders,
+	utils.SMS_PROVIDERS:         notificationProviders.ImportAllSmsProviders,
+	utils.SMS_TEMPLATES:         notificationTemplates.ImportAllSmsTemplates,
+	utils.ACTIONS:               actions.ImportAll,
+	utils.ORGANIZATIONS:         organizations.ImportAll,
+	utils.BRANDING:              branding.ImportAll,
+	utils.FLOWS:                 flows.ImportAll,
+}
+
+// Functions that register the identifiers of the deployed resources of each referenced resource type.
+var identifierRegistrationFunctions = map[utils.ResourceType]func(string) error{
+	utils.APPLICATIONS: applications.RegisterDeployedIdentifiers,
+	utils.ROLES:        roles.RegisterDeployedIdentifiers,
+}
+
+// Client to export and import the resources of an environment from other Go programs. Each client has its own
+// session, so a program can work with several environments one after the other. The clients share the package-level
+// state of the tool, so only one client runs an operation at a time, see utils.Session.
+type Client struct {
+	session *utils.Session
+}
+
+// Creates a client for the environment with the given config folder. The configs are loaded from the environment
+// variables if the config folder is not given. Returns a utils.ConfigError or a utils.AuthError on failure.
+func New(envConfigPath string, operation string) (*Client, error) {
+
+	session, err := utils.NewSession(envConfigPath, operation)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{session: session}, nil
+}
+
+func (client *Client) Session() *utils.Session {
+
+	return client.session
+}
+
+// Exports the resources of a resource type to the output directory, or to the base directory of the environment
+// if the output directory is not given. The identifiers of the referenced resources are registered first.
+// Returns a utils.OperationError if any resources fail to be exported.
+func (client *Client) Export(resourceType utils.ResourceType, outputDirPath, format string) error {
+
+	if !IsSupported(resourceType) {
+		return fmt.Errorf("unsupported resource type: %s", resourceType)
+	}
+	return client.run(utils.EXPORT, func() {
+		RegisterReferencedIdentifiers(resourceType, utils.EXPORT)
+		ExportResourceType(resourceType, client.resolveDirPath(outputDirPath), format)
+	})
+}
+
+// Imports the resources of a resource type from the input directory, or from the base directory of the environment
+// if the input directory is not given. The identifiers of the referenced resources are registered first.
+// Returns a utils.OperationError if any resources fail to be imported.
+func (client *Client) Import(resourceType utils.ResourceType, inputDirPath string) error {
+
+	if !IsSupported(resourceType) {
+		return fmt.Errorf("unsupported resource type: %s", resourceType)
+	}
+	return client.run(utils.IMPORT, func() {
+		RegisterReferencedIdentifiers(resourceType, utils.IMPORT)
+		ImportResourceType(resourceType, client.resolveDirPath(inputDirPath))
+	})
+}
+
+// Exports the resources of all the resource types in the dependency order. Returns a utils.OperationError if any
+// resources fail to be exported.
+func (client *Client) ExportAll(outputDirPath, format string) error {
+
+	return client.run(utils.EXPORT, func() {
+		for _, resourceType := range utils.ResourceOrder {
+			ExportResourceType(resourceType, client.resolveDirPath(outputDirPath), format)
+		}
+	})
+}
+
+// Imports the resources of all the resource types in the dependency order. The deleted identity providers are
+// removed after the applications using them are updated. Returns a utils.OperationError if any resources fail
+// to be imported.
+func (client *Client) ImportAll(inputDirPath string) error {
+
+	return client.run(utils.IMPORT, func() {
+		for _, resourceType := range utils.ResourceOrder {
+			ImportResourceType(resourceType, client.resolveDirPath(inputDirPath))
+		}
+		identityproviders.RemoveDeletedDeployedIdps(client.resolveDirPath(inputDirPath))
+	})
+}
+
+// Returns the summary report of the operations run by the client.
+func (client *Client) Summary(operation string) utils.SummaryReport {
+
+	return client.session.SummaryReport(operation)
+}
+
+// Runs an operation in the session of the client, and returns the failures it added to the summary.
+func (client *Client) run(operation string, process func()) error {
+
+	before := client.session.SummaryReport(operation)
+	client.session.Run(process)
+	return utils.NewOperationError(before, client.session.SummaryReport(operation))
+}
+
+func (client *Client) resolveDirPath(dirPath string) string {
+
+	if dirPath == "" {
+		return client.session.BaseDir()
+	}
+	return dirPath
+}
+
+// Checks whether the resources of a resource type can be exported and imported.
+func IsSupported(resourceType utils.ResourceType) bool {
+
+	_, exists := exportFunctions[resourceType]
+	return exists
+}
+
+// Exports the resources of a resource type with the active configs, and records the time taken in the summary.
+func ExportResourceType(resourceType utils.ResourceType, outputDirPath, format string) {
+
+	exportFunc, exists := exportFunctions[resourceType]
+	if !exists {
+		return
+	}
+	if resourceType != utils.BRANDING {
+		utils.MarkResTypeStart(resourceType)
+	}
+	exportFunc(outputDirPath, format)
+	if resourceType != utils.BRANDING {
+		utils.MarkResTypeEnd(resourceType)
+	}
+}
+
+// Imports the resources of a resource type with the active configs, and records the time taken in the summary.
+func ImportResourceType(resourceType utils.ResourceType, inputDirPath string) {
+
+	importFunc, exists := importFunctions[resourceType]
+	if !exists {
+		return
+	}
+	if resourceType != utils.BRANDING {
+		utils.MarkResTypeStart(resourceType)
+	}
+	importFunc(inputDirPath)
+	if resourceType != utils.BRANDING {
+		utils.MarkResTypeEnd(resourceType)
+	}
+}
+
+// Registers the identifiers of the deployed resources referenced by the given resource type, since the referenced
+// resource types are not processed when a single resource type is handled.
+func RegisterReferencedIdentifiers(resourceType utils.ResourceType, operation string) {
+
+	for _, reference := range utils.RESOURCE_REFERENCE_METADATA[resourceType] {
+		registerFunc, exists := identifierRegistrationFunctions[reference.ReferencedResourceType]
+		if !exists {
+			continue
+		}
+		if err := registerFunc(operation); err != nil {
+			utils.PrintLog(utils.LogLevelWarn, resourceType, "", fmt.Sprintf("Error retrieving the deployed %s to resolve references: %s",
+				reference.ReferencedResourceType, err))
+		}
+	}
+}
@@ -143,6 +143,11 @@ func getEmailTemplateKeywordMapping(typeName string) map[string]interface{} {
 
 func setNotificationTemplatesApiExists() {
 
+	if available, probed := utils.GetProbedCapability(utils.CAPABILITY_NOTIFICATION_TEMPLATES_API); probed {
+		utils.NotificationTemplatesApiExists = available
+		return
+	}
+
 	if utils.SERVER_CONFIGS.ServerVersion == "" {
 		utils.NotificationTemplatesApiExists = true
 		return
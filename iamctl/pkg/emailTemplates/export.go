@@ -20,7 +20,6 @@ package emailTemplates
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -47,7 +46,7 @@ func ExportAllLegacyApi(exportFilePath string, format string) {
 		return
 	}
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.EMAIL_TEMPLATES, "", fmt.Sprintf("Error creating email templates directory: %s", err))
 			utils.MarkResTypeFailure(utils.EMAIL_TEMPLATES)
 			return
@@ -69,7 +68,7 @@ func ExportAllLegacyApi(exportFilePath string, format string) {
 				utils.PrintLog(utils.LogLevelInfo, utils.EMAIL_TEMPLATES, emailType.DisplayName, "Exporting")
 				err := exportEmailTemplateType(emailType.ID, emailType.DisplayName, exportFilePath, format)
 				if err != nil {
-					utils.UpdateFailureSummary(utils.EMAIL_TEMPLATES, emailType.DisplayName)
+					utils.UpdateFailureSummary(utils.EMAIL_TEMPLATES, emailType.DisplayName, err)
 					utils.PrintLog(utils.LogLevelError, utils.EMAIL_TEMPLATES, emailType.DisplayName, fmt.Sprintf("Error while exporting: %s", err))
 				} else {
 					utils.UpdateSuccessSummary(utils.EMAIL_TEMPLATES, utils.EXPORT)
@@ -92,7 +91,7 @@ func exportEmailTemplateType(typeId, displayName, parentDir, formatString string
 	typeDir := filepath.Join(parentDir, displayName)
 
 	if _, err := os.Stat(typeDir); os.IsNotExist(err) {
-		if err := os.MkdirAll(typeDir, 0700); err != nil {
+		if err := utils.CreateExportDirectory(typeDir); err != nil {
 			return fmt.Errorf("error creating template type directory: %w", err)
 		}
 	} else {
@@ -132,7 +131,7 @@ func exportEmailTemplate(typeId, templateId, typeDir string, format utils.Format
 		return fmt.Errorf("error while serializing email template: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
@@ -78,8 +78,10 @@ func ImportAllLegacyApi(inputDirPath string) {
 		if !utils.IsResourceExcluded(displayName, utils.TOOL_CONFIGS.EmailTemplateConfigs) {
 			err := importEmailTemplateType(localTypePath, displayName, deployedTypes)
 			if err != nil {
-				utils.UpdateFailureSummary(utils.EMAIL_TEMPLATES, displayName)
+				utils.UpdateFailureSummary(utils.EMAIL_TEMPLATES, displayName, err)
 				utils.PrintLog(utils.LogLevelError, utils.EMAIL_TEMPLATES, displayName, fmt.Sprintf("Error importing: %s", err))
+			} else {
+				utils.MarkResourceCompleted(utils.EMAIL_TEMPLATES, displayName)
 			}
 		}
 	}
@@ -218,7 +220,7 @@ func removeDeletedDeployedTypes(localDirs []os.FileInfo, deployedTypes []emailTe
 		}
 		utils.PrintLog(utils.LogLevelInfo, utils.EMAIL_TEMPLATES, deployedType.DisplayName, "Not found locally. Deleting template type.")
 		if err := utils.SendDeleteRequest(deployedType.ID, utils.EMAIL_TEMPLATES); err != nil {
-			utils.UpdateFailureSummary(utils.EMAIL_TEMPLATES, deployedType.DisplayName)
+			utils.UpdateFailureSummary(utils.EMAIL_TEMPLATES, deployedType.DisplayName, err)
 			utils.PrintLog(utils.LogLevelError, utils.EMAIL_TEMPLATES, deployedType.DisplayName, fmt.Sprintf("Error deleting email template type: %s", err))
 		} else {
 			utils.UpdateSuccessSummary(utils.EMAIL_TEMPLATES, utils.DELETE)
@@ -20,7 +20,6 @@ package flows
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -36,7 +35,7 @@ func ExportAll(exportFilePath string, format string) {
 		return
 	}
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.FLOWS, "", fmt.Sprintf("Error creating flows directory: %s", err))
 			utils.MarkResTypeFailure(utils.FLOWS)
 			return
@@ -50,7 +49,7 @@ func ExportAll(exportFilePath string, format string) {
 
 			exists, err := exportFlow(name, id, exportFilePath, format)
 			if err != nil {
-				utils.UpdateFailureSummary(utils.FLOWS, name)
+				utils.UpdateFailureSummary(utils.FLOWS, name, err)
 				utils.PrintLog(utils.LogLevelError, utils.FLOWS, name, fmt.Sprintf("Error while exporting: %s", err))
 			} else {
 				if exists {
@@ -72,7 +71,7 @@ func ExportAll(exportFilePath string, format string) {
 func exportFlow(name, id string, outputDirPath string, formatString string) (exists bool, err error) {
 
 	if name == invitedUserRegistrationFlowName {
-		if _, exists := utils.GetResourceIdentifierMap(utils.GOVERNANCE_CONNECTORS)[utils.USER_ONBOARDING_GOVERNANCE_CATEGORY_ID]; !exists {
+		if _, exists := utils.GetResourceIdentifier(utils.GOVERNANCE_CONNECTORS, utils.USER_ONBOARDING_GOVERNANCE_CATEGORY_ID); !exists {
 			return false, fmt.Errorf("required resource %s governance connector category has not been exported", utils.USER_ONBOARDING_GOVERNANCE_CATEGORY_NAME)
 		}
 	}
@@ -99,7 +98,7 @@ func exportFlow(name, id string, outputDirPath string, formatString string) (exi
 		return false, fmt.Errorf("error while serializing flow: %w", err)
 	}
 
-	if err := ioutil.WriteFile(exportedFileName, modifiedFile, 0644); err != nil {
+	if err := utils.WriteExportedFile(exportedFileName, modifiedFile); err != nil {
 		return false, fmt.Errorf("error when writing exported content to file: %w", err)
 	}
 
@@ -57,14 +57,16 @@ func ImportAll(inputDirPath string) {
 			id, ok := flowTypes[name]
 			if !ok {
 				utils.PrintLog(utils.LogLevelError, utils.FLOWS, name, "Error importing flow: unknown flow type")
-				utils.UpdateFailureSummary(utils.FLOWS, name)
+				utils.UpdateFailureSummary(utils.FLOWS, name, nil)
 				continue
 			}
 
 			err := importFlow(name, id, flowFilePath)
 			if err != nil {
-				utils.UpdateFailureSummary(utils.FLOWS, name)
+				utils.UpdateFailureSummary(utils.FLOWS, name, err)
 				utils.PrintLog(utils.LogLevelError, utils.FLOWS, name, fmt.Sprintf("Error importing flow: %s", err))
+			} else {
+				utils.MarkResourceCompleted(utils.FLOWS, name)
 			}
 		}
 	}
@@ -73,7 +75,7 @@ func ImportAll(inputDirPath string) {
 func importFlow(name, id, importFilePath string) error {
 
 	if name == invitedUserRegistrationFlowName {
-		if _, exists := utils.GetResourceIdentifierMap(utils.GOVERNANCE_CONNECTORS)[utils.USER_ONBOARDING_GOVERNANCE_CATEGORY_NAME]; !exists {
+		if _, exists := utils.GetResourceIdentifier(utils.GOVERNANCE_CONNECTORS, utils.USER_ONBOARDING_GOVERNANCE_CATEGORY_NAME); !exists {
 			return fmt.Errorf("required resource %s governance connector category has not been imported", utils.USER_ONBOARDING_GOVERNANCE_CATEGORY_NAME)
 		}
 	}
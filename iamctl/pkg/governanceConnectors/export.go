@@ -20,7 +20,6 @@ package governanceConnectors
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -36,7 +35,7 @@ func ExportAll(exportFilePath string, format string) {
 		return
 	}
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.GOVERNANCE_CONNECTORS, "", fmt.Sprintf("Error creating governance connectors directory: %s", err))
 			utils.MarkResTypeFailure(utils.GOVERNANCE_CONNECTORS)
 			return
@@ -60,7 +59,7 @@ func ExportAll(exportFilePath string, format string) {
 
 			err := exportCategory(catInfo.Id, catInfo.Name, exportFilePath, format)
 			if err != nil {
-				utils.UpdateFailureSummary(utils.GOVERNANCE_CONNECTORS, catInfo.Name)
+				utils.UpdateFailureSummary(utils.GOVERNANCE_CONNECTORS, catInfo.Name, err)
 				utils.PrintLog(utils.LogLevelError, utils.GOVERNANCE_CONNECTORS, catInfo.Name, fmt.Sprintf("Error while exporting: %s", err))
 			} else {
 				utils.UpdateSuccessSummary(utils.GOVERNANCE_CONNECTORS, utils.EXPORT)
@@ -86,7 +85,7 @@ func exportCategory(catId, catName, parentDir, formatString string) error {
 	categoryDir := filepath.Join(parentDir, catName)
 
 	if _, err := os.Stat(categoryDir); os.IsNotExist(err) {
-		if err := os.MkdirAll(categoryDir, 0700); err != nil {
+		if err := utils.CreateExportDirectory(categoryDir); err != nil {
 			return fmt.Errorf("error creating connector category directory: %w", err)
 		}
 	} else {
@@ -133,7 +132,7 @@ func exportConnector(connectorId, connectorName, categoryId, categoryDir string,
 		return fmt.Errorf("error while serializing connector: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error writing exported content to file: %w", err)
 	}
@@ -135,7 +135,6 @@ func processPasswordExpiryConnector(data interface{}, deployedRuleNames []string
 		return fmt.Errorf("unexpected format for properties")
 	}
 
-	roleMap := utils.GetResourceIdentifierMap(utils.ROLES)
 	var filtered []interface{}
 	localRuleNames := make(map[string]bool)
 
@@ -172,7 +171,7 @@ func processPasswordExpiryConnector(data interface{}, deployedRuleNames []string
 			continue
 		case "roles":
 			for i := 4; i < len(parts); i++ {
-				replacement, exists := roleMap[parts[i]]
+				replacement, exists := utils.GetResourceIdentifier(utils.ROLES, parts[i])
 				if !exists {
 					return fmt.Errorf("referenced Role with identifier '%s' has not been successfully handled", parts[i])
 				}
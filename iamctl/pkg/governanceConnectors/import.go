@@ -64,8 +64,10 @@ func ImportAll(inputDirPath string) {
 		if !utils.IsResourceExcluded(catName, utils.TOOL_CONFIGS.GovernanceConnectorConfigs) {
 			err := importCategory(localCategoryPath, catName, deployedCategories)
 			if err != nil {
-				utils.UpdateFailureSummary(utils.GOVERNANCE_CONNECTORS, catName)
+				utils.UpdateFailureSummary(utils.GOVERNANCE_CONNECTORS, catName, err)
 				utils.PrintLog(utils.LogLevelError, utils.GOVERNANCE_CONNECTORS, catName, fmt.Sprintf("Error importing: %s", err))
+			} else {
+				utils.MarkResourceCompleted(utils.GOVERNANCE_CONNECTORS, catName)
 			}
 		}
 	}
@@ -40,7 +40,7 @@ func ExportAll(exportFilePath string, format string) {
 		return
 	}
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.IDENTITY_PROVIDERS, "", fmt.Sprintf("Error creating identity providers directory: %s", err))
 			utils.MarkResTypeFailure(utils.IDENTITY_PROVIDERS)
 			return
@@ -61,26 +61,27 @@ func ExportAll(exportFilePath string, format string) {
 		utils.PrintLog(utils.LogLevelError, utils.IDENTITY_PROVIDERS, "", fmt.Sprintf("Error retrieving the deployed identity providers list: %s", err))
 		utils.MarkResTypeFailure(utils.IDENTITY_PROVIDERS)
 	} else {
-		for _, idp := range idps {
+		utils.ProcessResources(len(idps), func(i int) {
+			idp := idps[i]
 			if !utils.IsResourceExcluded(idp.Name, utils.TOOL_CONFIGS.IdpConfigs) {
 				utils.PrintLog(utils.LogLevelInfo, utils.IDENTITY_PROVIDERS, idp.Name, "Exporting")
 
 				err := exportIdpWithCRUD(idp.Id, idp.Name, exportFilePath, format, excludeSecerts)
 				if err != nil {
-					utils.UpdateFailureSummary(utils.IDENTITY_PROVIDERS, idp.Name)
+					utils.UpdateFailureSummary(utils.IDENTITY_PROVIDERS, idp.Name, err)
 					utils.PrintLog(utils.LogLevelError, utils.IDENTITY_PROVIDERS, idp.Name, fmt.Sprintf("Error while exporting: %s", err))
 				} else {
 					utils.UpdateSuccessSummary(utils.IDENTITY_PROVIDERS, utils.EXPORT)
 					utils.PrintLog(utils.LogLevelInfo, utils.IDENTITY_PROVIDERS, idp.Name, "Exported successfully")
 				}
 			}
-		}
+		})
 	}
 	if !utils.IsResourceExcluded(utils.RESIDENT_IDP_NAME, utils.TOOL_CONFIGS.IdpConfigs) && exportAPIExists {
 		utils.PrintLog(utils.LogLevelInfo, utils.IDENTITY_PROVIDERS, utils.RESIDENT_IDP_NAME, "Exporting Resident identity provider")
 		err := exportIdp(utils.RESIDENT_IDP_NAME, exportFilePath, format, excludeSecerts)
 		if err != nil {
-			utils.UpdateFailureSummary(utils.IDENTITY_PROVIDERS, utils.RESIDENT_IDP_NAME)
+			utils.UpdateFailureSummary(utils.IDENTITY_PROVIDERS, utils.RESIDENT_IDP_NAME, err)
 			utils.PrintLog(utils.LogLevelError, utils.IDENTITY_PROVIDERS, utils.RESIDENT_IDP_NAME, fmt.Sprintf("Error while exporting resident identity provider: %s", err))
 		} else {
 			utils.UpdateSuccessSummary(utils.IDENTITY_PROVIDERS, utils.EXPORT)
@@ -107,14 +108,14 @@ func exportIdp(idpId string, outputDirPath string, format string, excludeSecrets
 
 	resp, err := utils.SendExportRequest(idpId, fileType, utils.IDENTITY_PROVIDERS, excludeSecrets)
 	if err != nil {
-		return fmt.Errorf("error while exporting the identity provider: %s", err)
+		return fmt.Errorf("error while exporting the identity provider: %w", err)
 	}
 	defer resp.Body.Close()
 
 	var attachmentDetail = resp.Header.Get("Content-Disposition")
 	_, params, err := mime.ParseMediaType(attachmentDetail)
 	if err != nil {
-		return fmt.Errorf("error while parsing the content disposition header: %s", err)
+		return fmt.Errorf("error while parsing the content disposition header: %w", err)
 	}
 
 	fileName := params["filename"]
@@ -130,11 +131,11 @@ func exportIdp(idpId string, outputDirPath string, format string, excludeSecrets
 	idpKeywordMapping := getIdpKeywordMapping(fileInfo.ResourceName)
 	modifiedFile, err := utils.ProcessExportedContent(exportedFileName, body, idpKeywordMapping, utils.IDENTITY_PROVIDERS_EXPORT_API)
 	if err != nil {
-		return fmt.Errorf("error while processing the exported content: %s", err)
+		return fmt.Errorf("error while processing the exported content: %w", err)
 	}
 	modifiedFile = processIdpGroupFields(modifiedFile)
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing the exported content to file: %w", err)
 	}
@@ -170,7 +171,7 @@ func exportIdpWithCRUD(idpId, idpName, outputDirPath, formatString string, exclu
 		return fmt.Errorf("error while serializing IDP: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"sync"
 
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/claims"
 	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
@@ -76,7 +77,7 @@ var idpPatchSkipKeys = map[string]bool{
 	"templateId":              true,
 }
 
-var customAuthSecretsWarningLogged bool
+var customAuthSecretsWarningOnce sync.Once
 
 func getIdpList() ([]identityProvider, error) {
 
@@ -171,9 +172,10 @@ func processFederatedAuthenticators(idpId string, idpStruct idpConfig, idpMap ma
 			return fmt.Errorf("unexpected format for definedBy field of federated authenticator: %s", authId)
 		}
 		if definedBy == "USER" {
-			if !excludeSecrets && !customAuthSecretsWarningLogged {
-				utils.PrintLog(utils.LogLevelWarn, utils.IDENTITY_PROVIDERS, "", "Secrets exclusion cannot be disabled for custom authenticators(service-based). All secrets will be masked.")
-				customAuthSecretsWarningLogged = true
+			if !excludeSecrets {
+				customAuthSecretsWarningOnce.Do(func() {
+					utils.PrintLog(utils.LogLevelWarn, utils.IDENTITY_PROVIDERS, "", "Secrets exclusion cannot be disabled for custom authenticators(service-based). All secrets will be masked.")
+				})
 			}
 			if err := processEndpointAuthProperties(fullAuthMap); err != nil {
 				return fmt.Errorf("error processing endpoint auth properties for authenticator %s: %v", authId, err)
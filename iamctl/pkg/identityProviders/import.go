@@ -57,7 +57,8 @@ func ImportAll(inputDirPath string) {
 		return
 	}
 
-	for _, file := range files {
+	utils.ProcessResources(len(files), func(i int) {
+		file := files[i]
 		idpFilePath := filepath.Join(importFilePath, file.Name())
 		fileInfo := utils.GetFileInfo(idpFilePath)
 		idpName := fileInfo.ResourceName
@@ -66,7 +67,7 @@ func ImportAll(inputDirPath string) {
 			var idpId string
 			if idpName == utils.RESIDENT_IDP_NAME {
 				if !exportAPIExists {
-					continue
+					return
 				}
 				idpId = utils.RESIDENT_IDP_NAME
 			} else {
@@ -76,10 +77,12 @@ func ImportAll(inputDirPath string) {
 			err := importIdp(idpId, idpName, idpFilePath, exportAPIExists)
 			if err != nil {
 				utils.PrintLog(utils.LogLevelError, utils.IDENTITY_PROVIDERS, idpName, fmt.Sprintf("Error importing identity provider: %s", err))
-				utils.UpdateFailureSummary(utils.IDENTITY_PROVIDERS, idpName)
+				utils.UpdateFailureSummary(utils.IDENTITY_PROVIDERS, idpName, err)
+			} else {
+				utils.MarkResourceCompleted(utils.IDENTITY_PROVIDERS, idpName)
 			}
 		}
-	}
+	})
 	if shouldRemoveOutboundProvisioningRoles() {
 		utils.PrintLog(utils.LogLevelWarn, utils.IDENTITY_PROVIDERS, "", "Outbound provisioning groups of identity providers are removed during import")
 	}
@@ -89,7 +92,7 @@ func importIdp(idpId string, idpName string, importFilePath string, exportAPIExi
 
 	fileBytes, err := ioutil.ReadFile(importFilePath)
 	if err != nil {
-		return fmt.Errorf("error when reading the file for identity provider: %s", err)
+		return fmt.Errorf("error when reading the file for identity provider: %w", err)
 	}
 
 	idpKeywordMapping := getIdpKeywordMapping(idpName)
@@ -115,7 +118,7 @@ func importIdentityProvider(idpName, importFilePath, modifiedFileData string) er
 	utils.PrintLog(utils.LogLevelInfo, utils.IDENTITY_PROVIDERS, idpName, "Creating new identity provider")
 	resp, err := utils.SendImportRequest(importFilePath, modifiedFileData, utils.IDENTITY_PROVIDERS)
 	if err != nil {
-		return fmt.Errorf("error when importing identity provider: %s", err)
+		return fmt.Errorf("error when importing identity provider: %w", err)
 	}
 	defer resp.Body.Close()
 	utils.UpdateSuccessSummary(utils.IDENTITY_PROVIDERS, utils.IMPORT)
@@ -128,7 +131,7 @@ func updateIdentityProvider(idpId, idpName, importFilePath, modifiedFileData str
 	utils.PrintLog(utils.LogLevelInfo, utils.IDENTITY_PROVIDERS, idpName, "Updating identity provider")
 	err := utils.SendUpdateRequest(idpId, importFilePath, modifiedFileData, utils.IDENTITY_PROVIDERS)
 	if err != nil {
-		return fmt.Errorf("error when updating identity provider: %s", err)
+		return fmt.Errorf("error when updating identity provider: %w", err)
 	}
 	utils.UpdateSuccessSummary(utils.IDENTITY_PROVIDERS, utils.UPDATE)
 	utils.PrintLog(utils.LogLevelInfo, utils.IDENTITY_PROVIDERS, idpName, "Updated successfully")
@@ -374,7 +377,7 @@ func RemoveDeletedDeployedIdps(inputDirPath string) {
 
 		utils.PrintLog(utils.LogLevelInfo, utils.IDENTITY_PROVIDERS, idp.Name, "Not found locally. Deleting idp.")
 		if err := utils.SendDeleteRequest(idp.Id, utils.IDENTITY_PROVIDERS); err != nil {
-			utils.UpdateFailureSummary(utils.IDENTITY_PROVIDERS, idp.Name)
+			utils.UpdateFailureSummary(utils.IDENTITY_PROVIDERS, idp.Name, err)
 			utils.PrintLog(utils.LogLevelError, utils.IDENTITY_PROVIDERS, idp.Name, fmt.Sprintf("Error deleting idp: %s", err))
 		} else {
 			utils.UpdateSuccessSummary(utils.IDENTITY_PROVIDERS, utils.DELETE)
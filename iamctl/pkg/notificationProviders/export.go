@@ -20,7 +20,6 @@ package notificationProviders
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -54,7 +53,7 @@ func exportAll(resType utils.ResourceType, exportFilePath string, format string)
 	}
 
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, resType, "", fmt.Sprintf("Error creating %s directory: %s", logName, err))
 			utils.MarkResTypeFailure(resType)
 			return
@@ -71,7 +70,7 @@ func exportAll(resType utils.ResourceType, exportFilePath string, format string)
 
 			err := exportProvider(resType, logName, provider.Name, exportFilePath, format)
 			if err != nil {
-				utils.UpdateFailureSummary(resType, provider.Name)
+				utils.UpdateFailureSummary(resType, provider.Name, err)
 				utils.PrintLog(utils.LogLevelError, resType, provider.Name, fmt.Sprintf("Error while exporting %s: %s", logName, err))
 			} else {
 				utils.UpdateSuccessSummary(resType, utils.EXPORT)
@@ -107,7 +106,7 @@ func exportProvider(resType utils.ResourceType, logName string, name string, out
 		return fmt.Errorf("error while serializing %s: %w", logName, err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
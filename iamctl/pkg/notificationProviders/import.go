@@ -73,7 +73,9 @@ func importAll(resType utils.ResourceType, inputDirPath string) {
 			err := importProvider(resType, logName, providerName, providerExists, providerFilePath)
 			if err != nil {
 				utils.PrintLog(utils.LogLevelError, resType, providerName, fmt.Sprintf("Error importing %s: %s", logName, err))
-				utils.UpdateFailureSummary(resType, providerName)
+				utils.UpdateFailureSummary(resType, providerName, err)
+			} else {
+				utils.MarkResourceCompleted(resType, providerName)
 			}
 		}
 	}
@@ -163,7 +165,7 @@ func removeDeletedDeployedProviders(resType utils.ResourceType, localFiles []os.
 
 		utils.PrintLog(utils.LogLevelInfo, resType, provider.Name, fmt.Sprintf("%s not found locally. Deleting.", logName))
 		if err := utils.SendDeleteRequest(provider.Name, resType); err != nil {
-			utils.UpdateFailureSummary(resType, provider.Name)
+			utils.UpdateFailureSummary(resType, provider.Name, err)
 			utils.PrintLog(utils.LogLevelError, resType, provider.Name, fmt.Sprintf("Error deleting %s: %s", logName, err))
 		} else {
 			utils.UpdateSuccessSummary(resType, utils.DELETE)
@@ -20,7 +20,6 @@ package applicationNotificationTemplates
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -54,7 +53,7 @@ func ExportTemplateType(rt utils.ResourceType, typeId, displayName, typeDir stri
 	if utils.TOOL_CONFIGS.AllowDelete && appsDirExistedBefore {
 		utils.RemoveDeletedLocalDirectories(appsDir, appsWithTemplates)
 		if len(appsWithTemplates) == 0 {
-			if err := os.Remove(appsDir); err != nil {
+			if err := utils.RemoveLocalPath(appsDir); err != nil {
 				utils.PrintLog(utils.LogLevelError, rt, displayName, fmt.Sprintf("Error removing application templates directory: %s", err))
 			} else {
 				utils.PrintLog(utils.LogLevelInfo, rt, displayName, fmt.Sprintf("Removed the directory: %s", ApplicationTemplatesDir))
@@ -76,7 +75,7 @@ func exportTemplatesOfApp(rt utils.ResourceType, typeId, appId, appName, appsDir
 
 	appDir := filepath.Join(appsDir, appName)
 	if _, err := os.Stat(appDir); os.IsNotExist(err) {
-		if err := os.MkdirAll(appDir, 0700); err != nil {
+		if err := utils.CreateExportDirectory(appDir); err != nil {
 			return false, fmt.Errorf("error creating template directory: %w", err)
 		}
 	} else if utils.TOOL_CONFIGS.AllowDelete {
@@ -109,7 +108,7 @@ func exportAppTemplate(rt utils.ResourceType, typeId, appId, locale, appDir stri
 	if err != nil {
 		return fmt.Errorf("error while serializing template: %w", err)
 	}
-	if err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644); err != nil {
+	if err = utils.WriteExportedFile(exportedFileName, modifiedFile); err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
 
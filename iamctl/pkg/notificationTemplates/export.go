@@ -20,7 +20,6 @@ package notificationTemplates
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -44,7 +43,7 @@ func ExportAll(rt utils.ResourceType, exportFilePath string, format string) {
 		return
 	}
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, rt, "", fmt.Sprintf("Error creating %s directory: %s", getTemplateLogName(rt), err))
 			utils.MarkResTypeFailure(rt)
 			return
@@ -60,7 +59,7 @@ func ExportAll(rt utils.ResourceType, exportFilePath string, format string) {
 			utils.PrintLog(utils.LogLevelInfo, rt, templateType.DisplayName, "Exporting")
 			hadTemplates, err := exportTemplateType(rt, templateType.ID, templateType.DisplayName, exportFilePath, format)
 			if err != nil {
-				utils.UpdateFailureSummary(rt, templateType.DisplayName)
+				utils.UpdateFailureSummary(rt, templateType.DisplayName, err)
 				utils.PrintLog(utils.LogLevelError, rt, templateType.DisplayName, fmt.Sprintf("Error while exporting: %s", err))
 			} else {
 				if hadTemplates {
@@ -78,7 +77,7 @@ func ExportAll(rt utils.ResourceType, exportFilePath string, format string) {
 
 	if err := writeTemplateTypesList(exportFilePath, allTypeNames, rt, utils.FormatFromString(format)); err != nil {
 		utils.PrintLog(utils.LogLevelError, rt, "", fmt.Sprintf("Error writing type list: %s", err))
-		utils.UpdateFailureSummary(rt, "TemplateTypes")
+		utils.UpdateFailureSummary(rt, "TemplateTypes", err)
 	}
 }
 
@@ -98,7 +97,7 @@ func exportTemplateType(rt utils.ResourceType, typeId, displayName, parentDir, f
 
 	if hadOrgTemplates {
 		if _, err := os.Stat(orgDir); os.IsNotExist(err) {
-			if err := os.MkdirAll(orgDir, 0700); err != nil {
+			if err := utils.CreateExportDirectory(orgDir); err != nil {
 				return false, fmt.Errorf("error creating template type directory: %w", err)
 			}
 		} else {
@@ -115,7 +114,7 @@ func exportTemplateType(rt utils.ResourceType, typeId, displayName, parentDir, f
 		}
 	} else if utils.TOOL_CONFIGS.AllowDelete {
 		if _, err := os.Stat(orgDir); err == nil {
-			if err := os.RemoveAll(orgDir); err != nil {
+			if err := utils.RemoveLocalDirectory(orgDir); err != nil {
 				utils.PrintLog(utils.LogLevelError, rt, displayName, fmt.Sprintf("Error removing organization templates directory: %s", err))
 			} else {
 				utils.PrintLog(utils.LogLevelInfo, rt, displayName, fmt.Sprintf("Removed the directory: %s", orgTemplatesDir))
@@ -150,7 +149,7 @@ func exportTemplate(rt utils.ResourceType, typeId, locale, typeDir string, forma
 		return fmt.Errorf("error while serializing template: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
@@ -57,7 +57,7 @@ func ImportAll(rt utils.ResourceType, inputDirPath string) {
 	exportedTypeNames, err := readLocalTemplateTypeNames(importFilePath, rt)
 	if err != nil {
 		utils.PrintLog(utils.LogLevelError, rt, "", fmt.Sprintf("Error reading type list: %s", err))
-		utils.UpdateFailureSummary(rt, "TemplateTypes")
+		utils.UpdateFailureSummary(rt, "TemplateTypes", err)
 		return
 	}
 
@@ -75,8 +75,10 @@ func ImportAll(rt utils.ResourceType, inputDirPath string) {
 		if !utils.IsResourceExcluded(displayName, getTemplateResourceConfig(rt)) {
 			err := importTemplateType(rt, localTypePath, displayName, deployedTypes, logName)
 			if err != nil {
-				utils.UpdateFailureSummary(rt, displayName)
+				utils.UpdateFailureSummary(rt, displayName, err)
 				utils.PrintLog(utils.LogLevelError, rt, displayName, fmt.Sprintf("Error when importing: %s", err))
+			} else {
+				utils.MarkResourceCompleted(rt, displayName)
 			}
 		}
 	}
@@ -231,14 +233,14 @@ func removeDeletedDeployedTypes(rt utils.ResourceType, localDirs []os.FileInfo,
 		if _, isExported := exportedNames[deployedType.DisplayName]; isExported {
 			utils.PrintLog(utils.LogLevelInfo, rt, deployedType.DisplayName, fmt.Sprintf("%s type not found locally. Resetting.", logName))
 			if err := resetTemplateType(rt, deployedType.ID); err != nil {
-				utils.UpdateFailureSummary(rt, deployedType.DisplayName)
+				utils.UpdateFailureSummary(rt, deployedType.DisplayName, err)
 				utils.PrintLog(utils.LogLevelError, rt, deployedType.DisplayName, fmt.Sprintf("Error resetting %s type: %s", logName, err))
 				continue
 			}
 		} else {
 			utils.PrintLog(utils.LogLevelInfo, rt, deployedType.DisplayName, fmt.Sprintf("%s type not found locally. Deleting.", logName))
 			if err := utils.SendDeleteRequest(deployedType.ID, rt); err != nil {
-				utils.UpdateFailureSummary(rt, deployedType.DisplayName)
+				utils.UpdateFailureSummary(rt, deployedType.DisplayName, err)
 				utils.PrintLog(utils.LogLevelError, rt, deployedType.DisplayName, fmt.Sprintf("Error deleting %s type: %s", logName, err))
 				continue
 			}
@@ -195,10 +195,11 @@ func resetTemplateType(rt utils.ResourceType, typeId string) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent {
+		errorBody, _ := ioutil.ReadAll(resp.Body)
 		if errMsg, ok := utils.ErrorCodes[resp.StatusCode]; ok {
-			return fmt.Errorf("error response for reset request: %s", errMsg)
+			return utils.NewServerError(resp.StatusCode, errorBody, fmt.Sprintf("error response for reset request: %s", errMsg))
 		}
-		return fmt.Errorf("unexpected error when resetting: %s", resp.Status)
+		return utils.NewServerError(resp.StatusCode, errorBody, fmt.Sprintf("unexpected error when resetting: %s", resp.Status))
 	}
 	return nil
 }
@@ -210,7 +211,7 @@ func writeTemplateTypesList(outputDirPath string, typeNames []string, rt utils.R
 	if err != nil {
 		return fmt.Errorf("error serializing list: %w", err)
 	}
-	if err := ioutil.WriteFile(exportedFileName, data, 0644); err != nil {
+	if err := utils.WriteExportedFile(exportedFileName, data); err != nil {
 		return fmt.Errorf("error writing file: %w", err)
 	}
 	return nil
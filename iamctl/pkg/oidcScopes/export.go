@@ -20,7 +20,6 @@ package oidcScopes
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -36,7 +35,7 @@ func ExportAll(exportFilePath string, format string) {
 		return
 	}
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.OIDC_SCOPES, "", fmt.Sprintf("Error creating OIDC scopes directory: %s", err))
 			utils.MarkResTypeFailure(utils.OIDC_SCOPES)
 			return
@@ -59,7 +58,7 @@ func ExportAll(exportFilePath string, format string) {
 
 				err := exportOidcScope(scope.Name, exportFilePath, format)
 				if err != nil {
-					utils.UpdateFailureSummary(utils.OIDC_SCOPES, scope.Name)
+					utils.UpdateFailureSummary(utils.OIDC_SCOPES, scope.Name, err)
 					utils.PrintLog(utils.LogLevelError, utils.OIDC_SCOPES, scope.Name, fmt.Sprintf("Error while exporting: %s", err))
 				} else {
 					utils.UpdateSuccessSummary(utils.OIDC_SCOPES, utils.EXPORT)
@@ -91,7 +90,7 @@ func exportOidcScope(scopeName string, outputDirPath string, formatString string
 		return fmt.Errorf("error while serializing scope: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
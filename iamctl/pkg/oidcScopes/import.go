@@ -68,7 +68,9 @@ func ImportAll(inputDirPath string) {
 			err := importOidcScope(scopeName, scopeExists, scopeFilePath)
 			if err != nil {
 				utils.PrintLog(utils.LogLevelError, utils.OIDC_SCOPES, scopeName, fmt.Sprintf("Error importing OIDC scope: %s", err))
-				utils.UpdateFailureSummary(utils.OIDC_SCOPES, scopeName)
+				utils.UpdateFailureSummary(utils.OIDC_SCOPES, scopeName, err)
+			} else {
+				utils.MarkResourceCompleted(utils.OIDC_SCOPES, scopeName)
 			}
 		}
 	}
@@ -158,7 +160,7 @@ func removeDeletedDeployedScopes(localFiles []os.FileInfo, deployedScopes []oidc
 
 		utils.PrintLog(utils.LogLevelInfo, utils.OIDC_SCOPES, scope.Name, "Not found locally. Deleting scope.")
 		if err := utils.SendDeleteRequest(scope.Name, utils.OIDC_SCOPES); err != nil {
-			utils.UpdateFailureSummary(utils.OIDC_SCOPES, scope.Name)
+			utils.UpdateFailureSummary(utils.OIDC_SCOPES, scope.Name, err)
 			utils.PrintLog(utils.LogLevelError, utils.OIDC_SCOPES, scope.Name, fmt.Sprintf("Error deleting OIDC scope: %s", err))
 		} else {
 			utils.UpdateSuccessSummary(utils.OIDC_SCOPES, utils.DELETE)
@@ -20,7 +20,6 @@ package organizations
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -43,7 +42,7 @@ func ExportAll(exportFilePath string, format string) {
 	}
 
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.ORGANIZATIONS, "", fmt.Sprintf("Error creating organizations directory: %s", err))
 			utils.MarkResTypeFailure(utils.ORGANIZATIONS)
 			return
@@ -62,7 +61,7 @@ func ExportAll(exportFilePath string, format string) {
 
 			err := exportOrganization(org.Id, resourceName, exportFilePath, format)
 			if err != nil {
-				utils.UpdateFailureSummary(utils.ORGANIZATIONS, resourceName)
+				utils.UpdateFailureSummary(utils.ORGANIZATIONS, resourceName, err)
 				utils.PrintLog(utils.LogLevelError, utils.ORGANIZATIONS, resourceName, fmt.Sprintf("Error while exporting: %s", err))
 			} else {
 				utils.UpdateSuccessSummary(utils.ORGANIZATIONS, utils.EXPORT)
@@ -98,7 +97,7 @@ func exportOrganization(orgId, resourceName, outputDirPath, formatString string)
 		return fmt.Errorf("error while serializing organization: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
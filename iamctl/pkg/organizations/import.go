@@ -74,7 +74,9 @@ func ImportAll(inputDirPath string) {
 			err := importOrganization(resourceName, orgId, orgFilePath)
 			if err != nil {
 				utils.PrintLog(utils.LogLevelError, utils.ORGANIZATIONS, resourceName, fmt.Sprintf("Error importing organization: %s", err))
-				utils.UpdateFailureSummary(utils.ORGANIZATIONS, resourceName)
+				utils.UpdateFailureSummary(utils.ORGANIZATIONS, resourceName, err)
+			} else {
+				utils.MarkResourceCompleted(utils.ORGANIZATIONS, resourceName)
 			}
 		}
 	}
@@ -179,7 +181,7 @@ func removeDeletedDeployedOrganizations(localFiles []os.FileInfo, deployedOrgs [
 
 		utils.PrintLog(utils.LogLevelInfo, utils.ORGANIZATIONS, resourceName, "Not found locally. Deleting organization.")
 		if err := utils.SendDeleteRequest(org.Id, utils.ORGANIZATIONS); err != nil {
-			utils.UpdateFailureSummary(utils.ORGANIZATIONS, resourceName)
+			utils.UpdateFailureSummary(utils.ORGANIZATIONS, resourceName, err)
 			utils.PrintLog(utils.LogLevelError, utils.ORGANIZATIONS, resourceName, fmt.Sprintf("Error deleting organization: %s", err))
 		} else {
 			utils.UpdateSuccessSummary(utils.ORGANIZATIONS, utils.DELETE)
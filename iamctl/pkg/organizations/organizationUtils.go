@@ -96,11 +96,12 @@ func getOrganizationList() ([]organization, error) {
 		}
 
 		if resp.StatusCode != http.StatusOK {
+			errorBody, _ := ioutil.ReadAll(resp.Body)
 			resp.Body.Close()
 			if errMsg, ok := utils.ErrorCodes[resp.StatusCode]; ok {
-				return nil, fmt.Errorf("error response for organization list page request: %s", errMsg)
+				return nil, utils.NewServerError(resp.StatusCode, errorBody, fmt.Sprintf("error response for organization list page request: %s", errMsg))
 			}
-			return nil, fmt.Errorf("unexpected error when retrieving organization list page: %s", resp.Status)
+			return nil, utils.NewServerError(resp.StatusCode, errorBody, fmt.Sprintf("unexpected error when retrieving organization list page: %s", resp.Status))
 		}
 
 		nextBody, err := ioutil.ReadAll(resp.Body)
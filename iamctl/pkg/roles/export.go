@@ -20,7 +20,6 @@ package roles
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -44,7 +43,7 @@ func ExportAll(exportFilePath string, format string) {
 	}
 
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.ROLES, "", fmt.Sprintf("Error creating roles directory: %s", err))
 			utils.MarkResTypeFailure(utils.ROLES)
 			return
@@ -56,13 +55,14 @@ func ExportAll(exportFilePath string, format string) {
 		}
 	}
 
-	for _, r := range roles {
+	utils.ProcessResources(len(roles), func(i int) {
+		r := roles[i]
 		if !utils.IsResourceExcluded(r.DisplayName, utils.TOOL_CONFIGS.RoleConfigs) {
 			utils.PrintLog(utils.LogLevelInfo, utils.ROLES, r.DisplayName, "Exporting")
 
 			err := exportRole(r, exportFilePath, format)
 			if err != nil {
-				utils.UpdateFailureSummary(utils.ROLES, r.DisplayName)
+				utils.UpdateFailureSummary(utils.ROLES, r.DisplayName, err)
 				utils.PrintLog(utils.LogLevelError, utils.ROLES, r.DisplayName, fmt.Sprintf("Error while exporting: %s", err))
 			} else {
 				utils.AddToIdentifierMap(utils.ROLES, r.Id, r.DisplayName, utils.EXPORT)
@@ -70,7 +70,7 @@ func ExportAll(exportFilePath string, format string) {
 				utils.PrintLog(utils.LogLevelInfo, utils.ROLES, r.DisplayName, "Exported successfully")
 			}
 		}
-	}
+	})
 
 }
 
@@ -101,7 +101,7 @@ func exportRole(r role, outputDirPath string, formatString string) error {
 		return fmt.Errorf("error while serializing role: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
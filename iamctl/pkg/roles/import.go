@@ -59,7 +59,8 @@ func ImportAll(inputDirPath string) {
 		removeDeletedDeployedRoles(files, existingRoleList)
 	}
 
-	for _, file := range files {
+	utils.ProcessResources(len(files), func(i int) {
+		file := files[i]
 		roleFilePath := filepath.Join(importFilePath, file.Name())
 		fileInfo := utils.GetFileInfo(roleFilePath)
 		displayName := unescapeName(fileInfo.ResourceName)
@@ -69,10 +70,12 @@ func ImportAll(inputDirPath string) {
 			err := importRole(displayName, roleId, roleFilePath)
 			if err != nil {
 				utils.PrintLog(utils.LogLevelError, utils.ROLES, displayName, fmt.Sprintf("Error importing role: %s", err))
-				utils.UpdateFailureSummary(utils.ROLES, displayName)
+				utils.UpdateFailureSummary(utils.ROLES, displayName, err)
+			} else {
+				utils.MarkResourceCompleted(utils.ROLES, displayName)
 			}
 		}
-	}
+	})
 }
 
 func importRole(displayName string, roleId string, importFilePath string) error {
@@ -193,7 +196,7 @@ func removeDeletedDeployedRoles(localFiles []os.FileInfo, deployedRoles []role)
 
 		utils.PrintLog(utils.LogLevelInfo, utils.ROLES, r.DisplayName, "Not found locally. Deleting role.")
 		if err := utils.SendDeleteRequest(r.Id, utils.ROLES); err != nil {
-			utils.UpdateFailureSummary(utils.ROLES, r.DisplayName)
+			utils.UpdateFailureSummary(utils.ROLES, r.DisplayName, err)
 			utils.PrintLog(utils.LogLevelError, utils.ROLES, r.DisplayName, fmt.Sprintf("Error deleting role: %s", err))
 		} else {
 			utils.UpdateSuccessSummary(utils.ROLES, utils.DELETE)
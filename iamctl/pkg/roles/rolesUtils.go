@@ -214,6 +214,11 @@ func unescapeName(sanitizedFileName string) string {
 
 func setRolesV2ApiExists() {
 
+	if available, probed := utils.GetProbedCapability(utils.CAPABILITY_ROLES_V2_API); probed {
+		utils.RolesV2ApiExists = available
+		return
+	}
+
 	res, err := utils.CompareVersions(utils.SERVER_CONFIGS.ServerVersion, utils.MIN_VERSION_ROLES_V2_API)
 
 	// Use the V2 API when the server version is ""
@@ -223,3 +228,18 @@ func setRolesV2ApiExists() {
 		utils.RolesV2ApiExists = false
 	}
 }
+
+// Registers the identifiers of the deployed roles, so that the references to them can be resolved
+// when the roles are not processed in the same run.
+func RegisterDeployedIdentifiers(operation string) error {
+
+	setRolesV2ApiExists()
+	roles, err := GetRoleList()
+	if err != nil {
+		return err
+	}
+	for _, r := range roles {
+		utils.AddToIdentifierMap(utils.ROLES, r.Id, r.DisplayName, operation)
+	}
+	return nil
+}
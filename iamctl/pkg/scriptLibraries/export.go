@@ -20,7 +20,6 @@ package scriptLibraries
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -36,7 +35,7 @@ func ExportAll(exportFilePath string, format string) {
 		return
 	}
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.SCRIPT_LIBRARIES, "", fmt.Sprintf("Error creating script libraries directory: %s", err))
 			utils.MarkResTypeFailure(utils.SCRIPT_LIBRARIES)
 			return
@@ -59,7 +58,7 @@ func ExportAll(exportFilePath string, format string) {
 
 				err := exportScriptLibrary(library.Name, exportFilePath, format)
 				if err != nil {
-					utils.UpdateFailureSummary(utils.SCRIPT_LIBRARIES, library.Name)
+					utils.UpdateFailureSummary(utils.SCRIPT_LIBRARIES, library.Name, err)
 					utils.PrintLog(utils.LogLevelError, utils.SCRIPT_LIBRARIES, library.Name, fmt.Sprintf("Error while exporting: %s", err))
 				} else {
 					utils.UpdateSuccessSummary(utils.SCRIPT_LIBRARIES, utils.EXPORT)
@@ -91,7 +90,7 @@ func exportScriptLibrary(libraryName string, outputDirPath string, formatString
 		return fmt.Errorf("error while serializing script library: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
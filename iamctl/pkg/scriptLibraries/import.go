@@ -67,7 +67,9 @@ func ImportAll(inputDirPath string) {
 			err := importScriptLibrary(libraryName, libraryExists, libraryFilePath)
 			if err != nil {
 				utils.PrintLog(utils.LogLevelError, utils.SCRIPT_LIBRARIES, libraryName, fmt.Sprintf("Error importing script library: %s", err))
-				utils.UpdateFailureSummary(utils.SCRIPT_LIBRARIES, libraryName)
+				utils.UpdateFailureSummary(utils.SCRIPT_LIBRARIES, libraryName, err)
+			} else {
+				utils.MarkResourceCompleted(utils.SCRIPT_LIBRARIES, libraryName)
 			}
 		}
 	}
@@ -157,7 +159,7 @@ func removeDeletedDeployedScriptLibraries(localFiles []os.FileInfo, deployedLibr
 
 		utils.PrintLog(utils.LogLevelInfo, utils.SCRIPT_LIBRARIES, library.Name, "Not found locally. Deleting library.")
 		if err := utils.SendDeleteRequest(library.Name, utils.SCRIPT_LIBRARIES); err != nil {
-			utils.UpdateFailureSummary(utils.SCRIPT_LIBRARIES, library.Name)
+			utils.UpdateFailureSummary(utils.SCRIPT_LIBRARIES, library.Name, err)
 			utils.PrintLog(utils.LogLevelError, utils.SCRIPT_LIBRARIES, library.Name, fmt.Sprintf("Error deleting script library: %s", err))
 		} else {
 			utils.UpdateSuccessSummary(utils.SCRIPT_LIBRARIES, utils.DELETE)
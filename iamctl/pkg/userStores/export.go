@@ -39,7 +39,7 @@ func ExportAll(exportFilePath string, format string) {
 		return
 	}
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.USERSTORES, "", fmt.Sprintf("Error creating user stores directory: %s", err))
 			utils.MarkResTypeFailure(utils.USERSTORES)
 			return
@@ -70,7 +70,7 @@ func ExportAll(exportFilePath string, format string) {
 				}
 
 				if err != nil {
-					utils.UpdateFailureSummary(utils.USERSTORES, userstore.Name)
+					utils.UpdateFailureSummary(utils.USERSTORES, userstore.Name, err)
 					utils.PrintLog(utils.LogLevelError, utils.USERSTORES, userstore.Name, fmt.Sprintf("Error while exporting: %s", err))
 				} else {
 					utils.UpdateSuccessSummary(utils.USERSTORES, utils.EXPORT)
@@ -99,13 +99,13 @@ func exportUserStore(userStoreId string, outputDirPath string, format string) er
 
 	resp, err := utils.SendExportRequest(userStoreId, fileType, utils.USERSTORES, true)
 	if err != nil {
-		return fmt.Errorf("error while exporting the user store: %s", err)
+		return fmt.Errorf("error while exporting the user store: %w", err)
 	}
 
 	var attachmentDetail = resp.Header.Get("Content-Disposition")
 	_, params, err := mime.ParseMediaType(attachmentDetail)
 	if err != nil {
-		return fmt.Errorf("error while parsing the content disposition header: %s", err)
+		return fmt.Errorf("error while parsing the content disposition header: %w", err)
 	}
 
 	fileName := params["filename"]
@@ -123,10 +123,10 @@ func exportUserStore(userStoreId string, outputDirPath string, format string) er
 	userStoreKeywordMapping := getUserStoreKeywordMapping(fileInfo.ResourceName)
 	modifiedFile, err := utils.ProcessExportedContent(exportedFileName, modifiedBody, userStoreKeywordMapping, utils.USERSTORES)
 	if err != nil {
-		return fmt.Errorf("error while processing the exported content: %s", err)
+		return fmt.Errorf("error while processing the exported content: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing the exported content to file: %w", err)
 	}
@@ -154,7 +154,7 @@ func exportUserStoreWithCRUD(userStoreId, userStoreName, outputDirPath, formatSt
 		return fmt.Errorf("error while serializing user store: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
@@ -64,7 +64,9 @@ func ImportAll(inputDirPath string) {
 				err := importUserStore(userStoreId, userStoreName, userStoreFilePath, exportAPIexists)
 				if err != nil {
 					utils.PrintLog(utils.LogLevelError, utils.USERSTORES, userStoreName, fmt.Sprintf("Error importing user store: %s", err))
-					utils.UpdateFailureSummary(utils.USERSTORES, userStoreName)
+					utils.UpdateFailureSummary(utils.USERSTORES, userStoreName, err)
+				} else {
+					utils.MarkResourceCompleted(utils.USERSTORES, userStoreName)
 				}
 			}
 		}
@@ -83,7 +85,7 @@ func importUserStore(userStoreId, userStoreName, userStoreFilePath string, expor
 	}
 	fileBytes, err := ioutil.ReadFile(userStoreFilePath)
 	if err != nil {
-		return fmt.Errorf("error when reading the file for user store: %s", err)
+		return fmt.Errorf("error when reading the file for user store: %w", err)
 	}
 
 	// Replace keyword placeholders in the local file according to the keyword mappings added in configs.
@@ -114,7 +116,7 @@ func importUserStoreOperation(userStoreName, userStoreFilePath, modifiedFileData
 	utils.PrintLog(utils.LogLevelInfo, utils.USERSTORES, userStoreName, "Creating new user store")
 	resp, err := utils.SendImportRequest(userStoreFilePath, modifiedFileData, utils.USERSTORES)
 	if err != nil {
-		return fmt.Errorf("error when importing user store: %s", err)
+		return fmt.Errorf("error when importing user store: %w", err)
 	}
 	defer resp.Body.Close()
 	utils.UpdateSuccessSummary(utils.USERSTORES, utils.IMPORT)
@@ -127,7 +129,7 @@ func updateUserStoreOperation(userStoreId, userStoreName, userStoreFilePath, mod
 	utils.PrintLog(utils.LogLevelInfo, utils.USERSTORES, userStoreName, "Updating user store")
 	err := utils.SendUpdateRequest(userStoreId, userStoreFilePath, modifiedFileData, utils.USERSTORES)
 	if err != nil {
-		return fmt.Errorf("error when updating user store: %s", err)
+		return fmt.Errorf("error when updating user store: %w", err)
 	}
 	utils.UpdateSuccessSummary(utils.USERSTORES, utils.UPDATE)
 	utils.PrintLog(utils.LogLevelInfo, utils.USERSTORES, userStoreName, "Updated successfully")
@@ -199,7 +201,7 @@ deployedResourcess:
 		utils.PrintLog(utils.LogLevelInfo, utils.USERSTORES, userstore.Name, "Not found locally. Deleting user store.")
 		err := utils.SendDeleteRequest(userstore.Id, utils.USERSTORES)
 		if err != nil {
-			utils.UpdateFailureSummary(utils.USERSTORES, userstore.Name)
+			utils.UpdateFailureSummary(utils.USERSTORES, userstore.Name, err)
 			utils.PrintLog(utils.LogLevelError, utils.USERSTORES, userstore.Name, fmt.Sprintf("Error deleting user store: %s", err))
 		}
 		utils.UpdateSuccessSummary(utils.USERSTORES, utils.DELETE)
@@ -77,7 +77,7 @@ func getUserStoreId(userStoreName string) (string, error) {
 
 	existingUserStoreList, err := getUserStoreList()
 	if err != nil {
-		return "", fmt.Errorf("error when retrieving the deployed user store list: %s", err)
+		return "", fmt.Errorf("error when retrieving the deployed user store list: %w", err)
 	}
 
 	for _, userstore := range existingUserStoreList {
@@ -20,7 +20,7 @@ package utils
 
 import (
 	"bytes"
-	"crypto/tls"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -48,6 +48,7 @@ type sendConfig struct {
 	contentType string
 	pathSuffix  string
 	queryParams map[string]string
+	ctx         context.Context
 }
 
 type SendOption func(*sendConfig)
@@ -123,13 +124,12 @@ func RemoveResponseFields(response interface{}, fieldsToRemove ...string) (inter
 func SendExportRequest(resourceId, fileType string, resourceType ResourceType, excludeSecrets bool) (resp *http.Response, err error) {
 
 	reqUrl := buildRequestUrl(EXPORT, resourceType, resourceId)
-	req, err := http.NewRequest("GET", reqUrl, strings.NewReader(""))
+	req, err := http.NewRequestWithContext(RequestContext(), "GET", reqUrl, strings.NewReader(""))
 	if err != nil {
-		return resp, fmt.Errorf("error while creating the export request: %s", err)
+		return resp, fmt.Errorf("error while creating the export request: %w", err)
 	}
 	req.Header.Set("Content-Type", MEDIA_TYPE_FORM)
 	req.Header.Set("accept", fileType)
-	req.Header.Set("Authorization", "Bearer "+SERVER_CONFIGS.Token)
 
 	query := req.URL.Query()
 	if resourceType == APPLICATIONS {
@@ -142,17 +142,9 @@ func SendExportRequest(resourceId, fileType string, resourceType ResourceType, e
 
 	defer req.Body.Close()
 
-	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
-
-	resp, err = httpClient.Do(req)
+	resp, err = sendRequest(req, nil)
 	if err != nil {
-		return resp, fmt.Errorf("error while exporting resource: %s", err)
+		return resp, fmt.Errorf("error while exporting resource: %w", err)
 	}
 
 	statusCode := resp.StatusCode
@@ -164,9 +156,9 @@ func SendExportRequest(resourceId, fileType string, resourceType ResourceType, e
 	debugBody, _ := ioutil.ReadAll(resp.Body)
 	PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("Response [%d]: %s", statusCode, string(debugBody)))
 	if error, ok := ErrorCodes[statusCode]; ok {
-		return resp, fmt.Errorf("error while exporting resource: %s", error)
+		return resp, NewServerError(statusCode, debugBody, fmt.Sprintf("error while exporting resource: %s", error))
 	}
-	return resp, fmt.Errorf("unexpected error while exporting the resource with status code: %s", strconv.FormatInt(int64(statusCode), 10))
+	return resp, NewServerError(statusCode, debugBody, fmt.Sprintf("unexpected error while exporting the resource with status code: %s", strconv.FormatInt(int64(statusCode), 10)))
 }
 
 func SendImportRequest(importFilePath, fileData string, resourceType ResourceType) (*http.Response, error) {
@@ -177,7 +169,7 @@ func SendImportRequest(importFilePath, fileData string, resourceType ResourceTyp
 	var err error
 	_, err = io.WriteString(&buf, fileData)
 	if err != nil {
-		return nil, fmt.Errorf("error when creating the import request: %s", err)
+		return nil, fmt.Errorf("error when creating the import request: %w", err)
 	}
 
 	mime.AddExtensionType(".yml", "application/yaml")
@@ -196,38 +188,24 @@ func SendImportRequest(importFilePath, fileData string, resourceType ResourceTyp
 		"Content-Type":        []string{mimeType},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("error when creating the import request: %s", err)
+		return nil, fmt.Errorf("error when creating the import request: %w", err)
 	}
 
 	_, err = io.Copy(part, &buf)
 	if err != nil {
-		return nil, fmt.Errorf("error when creating the import request: %s", err)
-	}
-
-	var capturedImportBody bytes.Buffer
-	var importBodyReader io.Reader = body
-	if TOOL_CONFIGS.Logs.LogRequestPayloads {
-		importBodyReader = io.TeeReader(body, &capturedImportBody)
+		return nil, fmt.Errorf("error when creating the import request: %w", err)
 	}
 
-	request, err := http.NewRequest("POST", reqUrl, importBodyReader)
+	request, err := http.NewRequestWithContext(RequestContext(), "POST", reqUrl, body)
 	if err != nil {
-		return nil, fmt.Errorf("error when creating the import request: %s", err)
+		return nil, fmt.Errorf("error when creating the import request: %w", err)
 	}
 	request.Header.Add("Content-Type", writer.FormDataContentType())
-	request.Header.Set("Authorization", "Bearer "+SERVER_CONFIGS.Token)
 	defer request.Body.Close()
 
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
-	resp, err := client.Do(request)
+	resp, err := sendRequest(request, []byte(fileData))
 	if err != nil {
-		return nil, fmt.Errorf("error when sending the import request: %s", err)
+		return nil, fmt.Errorf("error when sending the import request: %w", err)
 	}
 
 	statusCode := resp.StatusCode
@@ -238,13 +216,13 @@ func SendImportRequest(importFilePath, fileData string, resourceType ResourceTyp
 	resp.Body.Close()
 	PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("%s %s", request.Method, request.URL.String()))
 	if TOOL_CONFIGS.Logs.LogRequestPayloads {
-		PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("Request body: %s", capturedImportBody.String()))
+		PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("Request body: %s", body.String()))
 	}
 	PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("Response [%d]: %s", statusCode, string(debugBody)))
 	if error, ok := ErrorCodes[statusCode]; ok {
-		return nil, fmt.Errorf("error response for the import request: %s", error)
+		return nil, NewServerError(statusCode, debugBody, fmt.Sprintf("error response for the import request: %s", error))
 	}
-	return nil, fmt.Errorf("unexpected error when importing resource: %s", resp.Status)
+	return nil, NewServerError(statusCode, debugBody, fmt.Sprintf("unexpected error when importing resource: %s", resp.Status))
 }
 
 func SendUpdateRequest(resourceId, importFilePath, fileData string, resourceType ResourceType) error {
@@ -256,7 +234,7 @@ func SendUpdateRequest(resourceId, importFilePath, fileData string, resourceType
 	var err error
 	_, err = io.WriteString(&buf, fileData)
 	if err != nil {
-		return fmt.Errorf("error when creating the import request: %s", err)
+		return fmt.Errorf("error when creating the import request: %w", err)
 	}
 
 	mime.AddExtensionType(".yml", "application/yaml")
@@ -275,38 +253,24 @@ func SendUpdateRequest(resourceId, importFilePath, fileData string, resourceType
 		"Content-Type":        []string{mimeType},
 	})
 	if err != nil {
-		return fmt.Errorf("error when creating the import request: %s", err)
+		return fmt.Errorf("error when creating the import request: %w", err)
 	}
 
 	_, err = io.Copy(part, &buf)
 	if err != nil {
-		return fmt.Errorf("error when creating the import request: %s", err)
+		return fmt.Errorf("error when creating the import request: %w", err)
 	}
 
-	var capturedUpdateBody bytes.Buffer
-	var updateBodyReader io.Reader = body
-	if TOOL_CONFIGS.Logs.LogRequestPayloads {
-		updateBodyReader = io.TeeReader(body, &capturedUpdateBody)
-	}
-
-	request, err := http.NewRequest("PUT", formattedReqUrl, updateBodyReader)
+	request, err := http.NewRequestWithContext(RequestContext(), "PUT", formattedReqUrl, body)
 	if err != nil {
-		return fmt.Errorf("error when creating the import request: %s", err)
+		return fmt.Errorf("error when creating the import request: %w", err)
 	}
 	request.Header.Add("Content-Type", writer.FormDataContentType())
-	request.Header.Set("Authorization", "Bearer "+SERVER_CONFIGS.Token)
 	defer request.Body.Close()
 
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
-	resp, err := client.Do(request)
+	resp, err := sendRequest(request, []byte(fileData))
 	if err != nil {
-		return fmt.Errorf("error when sending the import request: %s", err)
+		return fmt.Errorf("error when sending the import request: %w", err)
 	}
 
 	statusCode := resp.StatusCode
@@ -320,24 +284,23 @@ func SendUpdateRequest(resourceId, importFilePath, fileData string, resourceType
 	resp.Body.Close()
 	PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("%s %s", request.Method, request.URL.String()))
 	if TOOL_CONFIGS.Logs.LogRequestPayloads {
-		PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("Request body: %s", capturedUpdateBody.String()))
+		PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("Request body: %s", body.String()))
 	}
 	PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("Response [%d]: %s", statusCode, string(debugBody)))
 	if error, ok := ErrorCodes[statusCode]; ok {
-		return fmt.Errorf("error response for the import request: %s", error)
+		return NewServerError(statusCode, debugBody, fmt.Sprintf("error response for the import request: %s", error))
 	}
-	return fmt.Errorf("unexpected error when importing resource: %s", resp.Status)
+	return NewServerError(statusCode, debugBody, fmt.Sprintf("unexpected error when importing resource: %s", resp.Status))
 }
 
 func SendDeleteRequest(resourceId string, resourceType ResourceType, opts ...SendOption) error {
 
 	cfg := applySendOptions(opts)
 	reqUrl := buildRequestUrl(DELETE, resourceType, resourceId)
-	request, err := http.NewRequest("DELETE", reqUrl, bytes.NewBuffer(nil))
+	request, err := http.NewRequestWithContext(cfg.ctx, "DELETE", reqUrl, bytes.NewBuffer(nil))
 	if err != nil {
-		return fmt.Errorf("error when creating the delete request: %s", err)
+		return fmt.Errorf("error when creating the delete request: %w", err)
 	}
-	request.Header.Set("Authorization", "Bearer "+SERVER_CONFIGS.Token)
 
 	query := request.URL.Query()
 	for k, v := range cfg.queryParams {
@@ -346,16 +309,9 @@ func SendDeleteRequest(resourceId string, resourceType ResourceType, opts ...Sen
 	request.URL.RawQuery = query.Encode()
 	defer request.Body.Close()
 
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
-	resp, err := client.Do(request)
+	resp, err := sendRequest(request, nil)
 	if err != nil {
-		return fmt.Errorf("error when sending the delete request: %s", err)
+		return fmt.Errorf("error when sending the delete request: %w", err)
 	}
 
 	statusCode := resp.StatusCode
@@ -367,9 +323,9 @@ func SendDeleteRequest(resourceId string, resourceType ResourceType, opts ...Sen
 	PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("%s %s", request.Method, request.URL.String()))
 	PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("Response [%d]: %s", statusCode, string(debugBody)))
 	if error, ok := ErrorCodes[statusCode]; ok {
-		return fmt.Errorf("error response for the delete request: %s", error)
+		return NewServerError(statusCode, debugBody, fmt.Sprintf("error response for the delete request: %s", error))
 	}
-	return fmt.Errorf("unexpected error when deleting resource: %s", resp.Status)
+	return NewServerError(statusCode, debugBody, fmt.Sprintf("unexpected error when deleting resource: %s", resp.Status))
 }
 
 func GetResourceData(resourceType ResourceType, resourceId string, opts ...SendOption) (interface{}, error) {
@@ -401,8 +357,14 @@ func WithQueryParams(params map[string]string) SendOption {
 	return func(c *sendConfig) { c.queryParams = params }
 }
 
+// WithContext sends the request with the given context instead of the request context of the run.
+func WithContext(ctx context.Context) SendOption {
+
+	return func(c *sendConfig) { c.ctx = ctx }
+}
+
 func applySendOptions(opts []SendOption) *sendConfig {
-	cfg := &sendConfig{contentType: MEDIA_TYPE_JSON}
+	cfg := &sendConfig{contentType: MEDIA_TYPE_JSON, ctx: RequestContext()}
 	for _, opt := range opts {
 		opt(cfg)
 	}
@@ -414,12 +376,11 @@ func SendGetRequest(resourceType ResourceType, resourceId string, opts ...SendOp
 	cfg := applySendOptions(opts)
 	reqUrl := buildRequestUrl(GET, resourceType, resourceId)
 	formattedReqUrl := addQueryParams(reqUrl, resourceType, GET)
-	request, err := http.NewRequest("GET", formattedReqUrl, nil)
+	request, err := http.NewRequestWithContext(cfg.ctx, "GET", formattedReqUrl, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating GET request: %w", err)
 	}
 
-	request.Header.Set("Authorization", "Bearer "+SERVER_CONFIGS.Token)
 	request.Header.Set("Accept", cfg.contentType)
 	query := request.URL.Query()
 	for k, v := range cfg.queryParams {
@@ -427,30 +388,22 @@ func SendGetRequest(resourceType ResourceType, resourceId string, opts ...SendOp
 	}
 	request.URL.RawQuery = query.Encode()
 
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
-
-	resp, err := client.Do(request)
+	resp, err := sendRequest(request, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error sending GET request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
+		debugBody, _ := ioutil.ReadAll(resp.Body)
 		if !(request.Method == "GET" && resp.StatusCode == 404) {
-			debugBody, _ := ioutil.ReadAll(resp.Body)
 			PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("%s %s", request.Method, request.URL.String()))
 			PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("Response [%d]: %s", resp.StatusCode, string(debugBody)))
 		}
 		if errMsg, ok := ErrorCodes[resp.StatusCode]; ok {
-			return nil, fmt.Errorf("error response for the GET request: %s", errMsg)
+			return nil, NewServerError(resp.StatusCode, debugBody, fmt.Sprintf("error response for the GET request: %s", errMsg))
 		}
-		return nil, fmt.Errorf("unexpected error when sending GET request: %s", resp.Status)
+		return nil, NewServerError(resp.StatusCode, debugBody, fmt.Sprintf("unexpected error when sending GET request: %s", resp.Status))
 	}
 
 	body, err := ioutil.ReadAll(resp.Body)
@@ -466,23 +419,14 @@ func SendPostRequest(resourceType ResourceType, requestBody []byte, opts ...Send
 	cfg := applySendOptions(opts)
 	reqUrl := buildRequestUrl(POST, resourceType, cfg.pathSuffix)
 
-	request, err := http.NewRequest("POST", reqUrl, bytes.NewBuffer(requestBody))
+	request, err := http.NewRequestWithContext(cfg.ctx, "POST", reqUrl, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return nil, fmt.Errorf("error creating POST request: %w", err)
 	}
 
-	request.Header.Set("Authorization", "Bearer "+SERVER_CONFIGS.Token)
 	request.Header.Set("Content-Type", cfg.contentType)
 
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
-
-	resp, err := client.Do(request)
+	resp, err := sendRequest(request, requestBody)
 	if err != nil {
 		return nil, fmt.Errorf("error sending POST request: %w", err)
 	}
@@ -496,9 +440,9 @@ func SendPostRequest(resourceType ResourceType, requestBody []byte, opts ...Send
 		}
 		PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("Response [%d]: %s", resp.StatusCode, string(debugBody)))
 		if errMsg, ok := ErrorCodes[resp.StatusCode]; ok {
-			return nil, fmt.Errorf("error response for the POST request: %s", errMsg)
+			return nil, NewServerError(resp.StatusCode, debugBody, fmt.Sprintf("error response for the POST request: %s", errMsg))
 		}
-		return nil, fmt.Errorf("unexpected error when sending POST request: %s", resp.Status)
+		return nil, NewServerError(resp.StatusCode, debugBody, fmt.Sprintf("unexpected error when sending POST request: %s", resp.Status))
 	}
 
 	return resp, nil
@@ -509,23 +453,14 @@ func SendPutRequest(resourceType ResourceType, resourceId string, requestBody []
 	cfg := applySendOptions(opts)
 	reqUrl := buildRequestUrl(PUT, resourceType, resourceId)
 
-	request, err := http.NewRequest("PUT", reqUrl, bytes.NewBuffer(requestBody))
+	request, err := http.NewRequestWithContext(cfg.ctx, "PUT", reqUrl, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return nil, fmt.Errorf("error creating PUT request: %w", err)
 	}
 
-	request.Header.Set("Authorization", "Bearer "+SERVER_CONFIGS.Token)
 	request.Header.Set("Content-Type", cfg.contentType)
 
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
-
-	resp, err := client.Do(request)
+	resp, err := sendRequest(request, requestBody)
 	if err != nil {
 		return nil, fmt.Errorf("error sending PUT request: %w", err)
 	}
@@ -539,9 +474,9 @@ func SendPutRequest(resourceType ResourceType, resourceId string, requestBody []
 		}
 		PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("Response [%d]: %s", resp.StatusCode, string(debugBody)))
 		if errMsg, ok := ErrorCodes[resp.StatusCode]; ok {
-			return nil, fmt.Errorf("error response for the PUT request: %s", errMsg)
+			return nil, NewServerError(resp.StatusCode, debugBody, fmt.Sprintf("error response for the PUT request: %s", errMsg))
 		}
-		return nil, fmt.Errorf("unexpected error when sending PUT request: %s", resp.Status)
+		return nil, NewServerError(resp.StatusCode, debugBody, fmt.Sprintf("unexpected error when sending PUT request: %s", resp.Status))
 	}
 
 	return resp, nil
@@ -550,23 +485,14 @@ func SendPutRequest(resourceType ResourceType, resourceId string, requestBody []
 func SendPatchRequest(resourceType ResourceType, resourceId string, requestBody []byte) (*http.Response, error) {
 
 	reqUrl := buildRequestUrl(PATCH, resourceType, resourceId)
-	request, err := http.NewRequest("PATCH", reqUrl, bytes.NewBuffer(requestBody))
+	request, err := http.NewRequestWithContext(RequestContext(), "PATCH", reqUrl, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return nil, fmt.Errorf("error creating PATCH request: %w", err)
 	}
 
-	request.Header.Set("Authorization", "Bearer "+SERVER_CONFIGS.Token)
 	request.Header.Set("Content-Type", MEDIA_TYPE_JSON)
 
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
-
-	resp, err := client.Do(request)
+	resp, err := sendRequest(request, requestBody)
 	if err != nil {
 		return nil, fmt.Errorf("error sending PATCH request: %w", err)
 	}
@@ -580,9 +506,9 @@ func SendPatchRequest(resourceType ResourceType, resourceId string, requestBody
 		}
 		PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("Response [%d]: %s", resp.StatusCode, string(debugBody)))
 		if errMsg, ok := ErrorCodes[resp.StatusCode]; ok {
-			return nil, fmt.Errorf("error response for the PATCH request: %s", errMsg)
+			return nil, NewServerError(resp.StatusCode, debugBody, fmt.Sprintf("error response for the PATCH request: %s", errMsg))
 		}
-		return nil, fmt.Errorf("unexpected error when sending PATCH request: %s", resp.Status)
+		return nil, NewServerError(resp.StatusCode, debugBody, fmt.Sprintf("unexpected error when sending PATCH request: %s", resp.Status))
 	}
 
 	return resp, nil
@@ -594,11 +520,10 @@ func SendGetListRequest(resourceType ResourceType, opts ...SendOption) ([]byte,
 	reqUrl := buildRequestUrl(LIST, resourceType, "")
 	reqUrl = addQueryParams(reqUrl, resourceType, LIST)
 
-	req, err := http.NewRequest("GET", reqUrl, bytes.NewBuffer(nil))
+	req, err := http.NewRequestWithContext(cfg.ctx, "GET", reqUrl, bytes.NewBuffer(nil))
 	if err != nil {
 		return nil, fmt.Errorf("error creating GET list request: %w", err)
 	}
-	req.Header.Set("Authorization", "Bearer "+SERVER_CONFIGS.Token)
 	req.Header.Set("Accept", MEDIA_TYPE_JSON)
 
 	query := req.URL.Query()
@@ -608,13 +533,7 @@ func SendGetListRequest(resourceType ResourceType, opts ...SendOption) ([]byte,
 	req.URL.RawQuery = query.Encode()
 	defer req.Body.Close()
 
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-		},
-	}
-
-	resp, err := client.Do(req)
+	resp, err := sendRequest(req, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error sending GET list request. %w", err)
 	}
@@ -625,9 +544,9 @@ func SendGetListRequest(resourceType ResourceType, opts ...SendOption) ([]byte,
 		PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("%s %s", req.Method, req.URL.String()))
 		PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("Response [%d]: %s", resp.StatusCode, string(debugBody)))
 		if errMsg, ok := ErrorCodes[resp.StatusCode]; ok {
-			return nil, fmt.Errorf("error response for the GET list request. Error: %s", errMsg)
+			return nil, NewServerError(resp.StatusCode, debugBody, fmt.Sprintf("error response for the GET list request. Error: %s", errMsg))
 		}
-		return nil, fmt.Errorf("unexpected error when sending GET list request: %d", resp.StatusCode)
+		return nil, NewServerError(resp.StatusCode, debugBody, fmt.Sprintf("unexpected error when sending GET list request: %d", resp.StatusCode))
 	}
 
 	body, err := ioutil.ReadAll(resp.Body)
@@ -718,24 +637,15 @@ func SendCustomRequest(method, reqURL string, body []byte, contentType string) (
 		reqBody = bytes.NewBuffer(body)
 	}
 
-	req, err := http.NewRequest(method, reqURL, reqBody)
+	req, err := http.NewRequestWithContext(RequestContext(), method, reqURL, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("error creating %s request: %w", method, err)
 	}
-	req.Header.Set("Authorization", "Bearer "+SERVER_CONFIGS.Token)
 	if contentType != "" {
 		req.Header.Set("Content-Type", contentType)
 	}
 
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
-
-	resp, err := client.Do(req)
+	resp, err := sendRequest(req, body)
 	if err != nil {
 		return nil, fmt.Errorf("error sending %s request: %w", method, err)
 	}
@@ -753,6 +663,40 @@ func SendCustomRequest(method, reqURL string, body []byte, contentType string) (
 	return resp, nil
 }
 
+func sendRequest(request *http.Request, requestBody []byte) (*http.Response, error) {
+
+	if DryRun {
+		if resp, ok := getDryRunResponse(request, requestBody); ok {
+			return resp, nil
+		}
+	}
+	token, err := getValidAccessToken()
+	if err != nil {
+		return nil, fmt.Errorf("error refreshing the access token: %w", err)
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	resp, err := doRequest(request)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || (request.Body != nil && request.GetBody == nil) {
+		return resp, err
+	}
+
+	// The access token may be revoked or expired before the expiry time. Retried once with a new access token.
+	token, err = refreshAccessToken(token)
+	if err != nil {
+		PrintLog(LogLevelError, UtilsResourceWrapper, "", fmt.Sprintf("Error refreshing the access token: %s", err))
+		return resp, nil
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	if request.Body != nil {
+		if request.Body, err = request.GetBody(); err != nil {
+			return nil, fmt.Errorf("error resetting the request body for retrying: %w", err)
+		}
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	return doRequest(request)
+}
+
 func getResourcePath(resourceType ResourceType) string {
 
 	switch resourceType {
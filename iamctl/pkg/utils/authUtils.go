@@ -0,0 +1,266 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Client authentication methods used when requesting access tokens.
+const (
+	CLIENT_AUTH_CLIENT_SECRET_BASIC = "client_secret_basic"
+	CLIENT_AUTH_PRIVATE_KEY_JWT     = "private_key_jwt"
+	CLIENT_AUTH_TLS_CLIENT_AUTH     = "tls_client_auth"
+)
+
+// Grant types used to get access tokens.
+const (
+	GRANT_TYPE_CLIENT_CREDENTIALS = "client_credentials"
+	GRANT_TYPE_TOKEN_EXCHANGE     = "token_exchange"
+)
+
+const (
+	TOKEN_EXCHANGE_GRANT_TYPE = "urn:ietf:params:oauth:grant-type:token-exchange"
+	TOKEN_TYPE_JWT            = "urn:ietf:params:oauth:token-type:jwt"
+	TOKEN_TYPE_ACCESS_TOKEN   = "urn:ietf:params:oauth:token-type:access_token"
+	CLIENT_ASSERTION_TYPE_JWT = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+	CLIENT_ASSERTION_LIFETIME = 5 * time.Minute
+)
+
+func validateAuthConfigs(config ServerConfigs) error {
+
+	switch config.ClientAuthMethod {
+	case "", CLIENT_AUTH_CLIENT_SECRET_BASIC:
+	case CLIENT_AUTH_PRIVATE_KEY_JWT:
+		if config.PrivateKeyPath == "" {
+			return errors.New("private key path is required for the private_key_jwt client authentication")
+		}
+	case CLIENT_AUTH_TLS_CLIENT_AUTH:
+		if config.ClientCertPath == "" {
+			return errors.New("client certificate is required for the tls_client_auth client authentication")
+		}
+	default:
+		return fmt.Errorf("unsupported client authentication method: %s", config.ClientAuthMethod)
+	}
+
+	switch config.GrantType {
+	case "", GRANT_TYPE_CLIENT_CREDENTIALS:
+	case GRANT_TYPE_TOKEN_EXCHANGE:
+		if config.SubjectToken == "" && config.SubjectTokenFile == "" {
+			return errors.New("subject token is required for the token exchange grant")
+		}
+	default:
+		return fmt.Errorf("unsupported grant type: %s", config.GrantType)
+	}
+	return nil
+}
+
+// Returns the access token issued outside the tool, if one is configured. A token file is read each time,
+// so that a token renewed in the file is used when the current token expires.
+func getPreIssuedToken(config ServerConfigs) (string, bool, error) {
+
+	if config.AccessTokenFile != "" {
+		token, err := readTokenFile(config.AccessTokenFile)
+		return token, true, err
+	}
+	return config.AccessToken, config.AccessToken != "", nil
+}
+
+// Returns the parameters of the grant used to get an access token.
+func getGrantParams(config ServerConfigs) (url.Values, error) {
+
+	body := url.Values{}
+	if config.GrantType == GRANT_TYPE_TOKEN_EXCHANGE {
+		subjectToken := config.SubjectToken
+		if config.SubjectTokenFile != "" {
+			var err error
+			if subjectToken, err = readTokenFile(config.SubjectTokenFile); err != nil {
+				return nil, err
+			}
+		}
+		subjectTokenType := config.SubjectTokenType
+		if subjectTokenType == "" {
+			subjectTokenType = TOKEN_TYPE_JWT
+		}
+		body.Set("grant_type", TOKEN_EXCHANGE_GRANT_TYPE)
+		body.Set("subject_token", subjectToken)
+		body.Set("subject_token_type", subjectTokenType)
+		body.Set("requested_token_type", TOKEN_TYPE_ACCESS_TOKEN)
+	} else {
+		body.Set("grant_type", GRANT_TYPE_CLIENT_CREDENTIALS)
+	}
+	body.Set("scope", getRequestedScope())
+	return body, nil
+}
+
+// Adds the client authentication parameters of the configured method to the token request.
+// Returns true if the client secret should be sent as basic authentication.
+func addClientAuthentication(config ServerConfigs, tokenUrl string, body url.Values) (bool, error) {
+
+	switch config.ClientAuthMethod {
+	case CLIENT_AUTH_PRIVATE_KEY_JWT:
+		assertion, err := buildClientAssertion(config, tokenUrl)
+		if err != nil {
+			return false, fmt.Errorf("error building the client assertion: %w", err)
+		}
+		body.Set("client_id", config.ClientId)
+		body.Set("client_assertion_type", CLIENT_ASSERTION_TYPE_JWT)
+		body.Set("client_assertion", assertion)
+		return false, nil
+	case CLIENT_AUTH_TLS_CLIENT_AUTH:
+		// The client is authenticated by the client certificate presented in the TLS handshake.
+		body.Set("client_id", config.ClientId)
+		return false, nil
+	default:
+		return true, nil
+	}
+}
+
+// Builds a JWT signed with the private key of the client, to authenticate the client to the token endpoint.
+func buildClientAssertion(config ServerConfigs, tokenUrl string) (string, error) {
+
+	privateKey, err := loadPrivateKey(config.PrivateKeyPath)
+	if err != nil {
+		return "", err
+	}
+
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+	now := time.Now()
+	claims := map[string]interface{}{
+		"iss": config.ClientId,
+		"sub": config.ClientId,
+		"aud": tokenUrl,
+		"jti": hex.EncodeToString(jti),
+		"iat": now.Unix(),
+		"exp": now.Add(CLIENT_ASSERTION_LIFETIME).Unix(),
+	}
+	header := map[string]interface{}{"typ": "JWT"}
+	if config.PrivateKeyId != "" {
+		header["kid"] = config.PrivateKeyId
+	}
+
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		header["alg"] = "RS256"
+	case *ecdsa.PrivateKey:
+		if key.Curve.Params().BitSize != 256 {
+			return "", errors.New("only P-256 EC keys are supported")
+		}
+		header["alg"] = "ES256"
+	default:
+		return "", errors.New("unsupported private key type. Use a RSA or an EC P-256 key")
+	}
+
+	signingInput, err := encodeJWTPart(header)
+	if err != nil {
+		return "", err
+	}
+	encodedClaims, err := encodeJWTPart(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput += "." + encodedClaims
+
+	digest := sha256.Sum256([]byte(signingInput))
+	var signature []byte
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+		if r, s, err = ecdsa.Sign(rand.Reader, key, digest[:]); err == nil {
+			// JWS uses the fixed length concatenation of r and s instead of the ASN.1 encoding.
+			signature = make([]byte, 64)
+			rBytes, sBytes := r.Bytes(), s.Bytes()
+			copy(signature[32-len(rBytes):32], rBytes)
+			copy(signature[64-len(sBytes):], sBytes)
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("error signing the client assertion: %w", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func encodeJWTPart(part map[string]interface{}) (string, error) {
+
+	data, err := json.Marshal(part)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Loads a PEM encoded PKCS#8, PKCS#1 or SEC 1 private key.
+func loadPrivateKey(keyPath string) (crypto.Signer, error) {
+
+	keyBytes, err := ioutil.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the private key file: %w", err)
+	}
+	block, _ := pem.Decode(keyBytes)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded private key found in: %s", keyPath)
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		if signer, ok := key.(crypto.Signer); ok {
+			return signer, nil
+		}
+		return nil, errors.New("unsupported private key type")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("error parsing the private key in: %s", keyPath)
+}
+
+func readTokenFile(filePath string) (string, error) {
+
+	tokenBytes, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("error reading the token file: %w", err)
+	}
+	token := strings.TrimSpace(string(tokenBytes))
+	if token == "" {
+		return "", fmt.Errorf("token file is empty: %s", filePath)
+	}
+	return token, nil
+}
@@ -0,0 +1,207 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// Capabilities of resource-specific APIs. The capabilities of resource types are named after the resource type.
+const (
+	CAPABILITY_ROLES_V2_API               = "RolesV2Api"
+	CAPABILITY_NOTIFICATION_TEMPLATES_API = "NotificationTemplatesApi"
+)
+
+type capabilityProbe struct {
+	capability string
+	path       string // Path of an API listing resources, relative to the tenant base URL
+	minVersion string
+	maxVersion string
+}
+
+// APIs probed to detect the capabilities of the server, with the versions they are available in.
+var capabilityProbes = []capabilityProbe{
+	{CAPABILITY_ROLES_V2_API, "/scim2/v2/Roles?count=1", MIN_VERSION_ROLES_V2_API, ""},
+	{CAPABILITY_NOTIFICATION_TEMPLATES_API, "/api/server/v1/notification/email/template-types", MIN_VERSION_NOTIFICATION_TEMPLATES_API, ""},
+	{API_RESOURCES.String(), "/api/server/v1/api-resources?limit=1", MIN_VERSION_API_RESOURCES, ""},
+	{SMS_TEMPLATES.String(), "/api/server/v1/notification/sms/template-types", MIN_VERSION_SMS_TEMPLATES, ""},
+	{ACTIONS.String(), "/api/server/v1/actions", MIN_VERSION_ACTIONS, ""},
+	{WORKFLOWS.String(), "/api/server/v1/workflows?limit=1", MIN_VERSION_WORKFLOWS, ""},
+	{EMAIL_PROVIDERS.String(), "/api/server/v2/notification-senders/email", MIN_VERSION_EMAIL_PROVIDERS, ""},
+	{SMS_PROVIDERS.String(), "/api/server/v2/notification-senders/sms", MIN_VERSION_SMS_PROVIDERS, ""},
+	{SCRIPT_LIBRARIES.String(), "/api/server/v1/script-libraries?limit=1", "", MAX_VERSION_SCRIPT_LIBRARIES},
+}
+
+var (
+	// Whether the server version is declared in the server configs. It is detected from the capabilities otherwise.
+	serverVersionDeclared bool
+	probedCapabilities    map[string]bool
+	capabilityLock        sync.RWMutex
+)
+
+// Detects the capabilities of the server, and logs a warning if the declared server version contradicts them.
+// If the server version is not declared, it is left empty so that the behaviour of the latest version is used along
+// with the probed capabilities, as the version derived from the capabilities may be older than the actual version.
+func initServerCapabilities() error {
+
+	// The APIs of the root organization are not accessible with an access token of a sub organization.
+	if IsSubOrganization() {
+		if !serverVersionDeclared {
+			return &ConfigError{Message: "server version cannot be detected for sub organizations. " +
+				"Add the server version to the server configs"}
+		}
+		return nil
+	}
+
+	PrintLog(LogLevelInfo, UtilsResourceWrapper, "", "Detecting the capabilities of the server.")
+	capabilities := ProbeServerCapabilities()
+	if !serverVersionDeclared {
+		// Servers older than 7.0.0 cannot be told apart by their APIs and need the server version to be declared.
+		version, err := DeriveServerVersion(capabilities)
+		if err != nil {
+			return &ConfigError{Message: "error detecting the server version", Err: err}
+		}
+		PrintLog(LogLevelInfo, UtilsResourceWrapper, "", fmt.Sprintf("Detected server version %s or later. "+
+			"Using the behaviour of the latest version. Add the server version to the server configs to use the "+
+			"behaviour of an earlier version.", version))
+		return nil
+	}
+	for _, mismatch := range GetVersionMismatches(SERVER_CONFIGS.ServerVersion, capabilities) {
+		PrintLog(LogLevelWarn, UtilsResourceWrapper, "", mismatch)
+	}
+	return nil
+}
+
+// Probes whether the APIs of each capability exist in the server, and caches the results for the run.
+// Capabilities that cannot be probed, e.g. due to server errors, are left out.
+func ProbeServerCapabilities() map[string]bool {
+
+	capabilities := make(map[string]bool)
+	for _, probe := range capabilityProbes {
+		resp, err := SendCustomRequest(http.MethodGet, GetTenantBaseUrl()+probe.path, nil, "")
+		if err != nil {
+			PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("Error probing %s: %s", probe.capability, err))
+			continue
+		}
+		resp.Body.Close()
+		// The API exists if the request is rejected for other reasons, e.g. due to missing scopes.
+		switch {
+		case resp.StatusCode == http.StatusNotFound:
+			capabilities[probe.capability] = false
+		case resp.StatusCode < http.StatusInternalServerError:
+			capabilities[probe.capability] = true
+		}
+	}
+
+	capabilityLock.Lock()
+	probedCapabilities = capabilities
+	capabilityLock.Unlock()
+	return capabilities
+}
+
+// Returns whether a capability is available in the server, and whether it was probed.
+func GetProbedCapability(capability string) (available bool, probed bool) {
+
+	capabilityLock.RLock()
+	defer capabilityLock.RUnlock()
+
+	available, probed = probedCapabilities[capability]
+	return available, probed
+}
+
+// Clears the probed capabilities, so that the capabilities are resolved from the server version.
+func ResetProbedCapabilities() {
+
+	capabilityLock.Lock()
+	probedCapabilities = nil
+	capabilityLock.Unlock()
+}
+
+// Derives the lowest server version having the given capabilities. Only versions from 7.0.0 can be derived,
+// as earlier versions cannot be told apart by their APIs.
+func DeriveServerVersion(capabilities map[string]bool) (string, error) {
+
+	version := ""
+	for _, probe := range capabilityProbes {
+		if probe.minVersion == "" || !capabilities[probe.capability] {
+			continue
+		}
+		if version == "" {
+			version = probe.minVersion
+		} else if cmp, _ := CompareVersions(probe.minVersion, version); cmp > 0 {
+			version = probe.minVersion
+		}
+	}
+	if version == "" {
+		return "", errors.New("server version could not be detected. Servers older than 7.0.0 require " +
+			"the server version in the server configs")
+	}
+	return version, nil
+}
+
+// Returns the capabilities that contradict the given server version.
+func GetVersionMismatches(version string, capabilities map[string]bool) []string {
+
+	var mismatches []string
+	for _, probe := range capabilityProbes {
+		available, probed := capabilities[probe.capability]
+		if !probed {
+			continue
+		}
+		expected := isAvailableInVersion(version, probe.minVersion, probe.maxVersion)
+		if available && !expected {
+			mismatches = append(mismatches, fmt.Sprintf("Declared server version %s contradicts the server: "+
+				"%s is available in the server.", versionLabel(version), probe.capability))
+		} else if !available && expected {
+			mismatches = append(mismatches, fmt.Sprintf("Declared server version %s contradicts the server: "+
+				"%s is not available in the server.", versionLabel(version), probe.capability))
+		}
+	}
+	return mismatches
+}
+
+// Checks whether a version is within the given bounds. An empty version is considered the latest version.
+func isAvailableInVersion(version, minVersion, maxVersion string) bool {
+
+	if version == "" {
+		return maxVersion == ""
+	}
+	if minVersion != "" {
+		if cmp, err := CompareVersions(version, minVersion); err == nil && cmp < 0 {
+			return false
+		}
+	}
+	if maxVersion != "" {
+		if cmp, err := CompareVersions(version, maxVersion); err == nil && cmp > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func versionLabel(version string) string {
+
+	if version == "" {
+		return "(latest)"
+	}
+	return version
+}
@@ -0,0 +1,374 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	CASSETTE_MODE_RECORD = "record"
+	CASSETTE_MODE_REPLAY = "replay"
+	CASSETTE_VERSION     = 1
+	CASSETTE_CLOSING     = "\n]}\n"
+	BODY_ENCODING_BASE64 = "base64"
+	// Multipart boundaries are random, so they are replaced with a fixed boundary to match the recorded requests.
+	CASSETTE_BOUNDARY = "iamctl-cassette-boundary"
+)
+
+// Requests and responses recorded from a session with a server, so that the session can be replayed offline.
+type Cassette struct {
+	Version      int                   `json:"version"`
+	Interactions []CassetteInteraction `json:"interactions"`
+}
+
+type CassetteInteraction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// The URL of a recorded request only has the path and query, so that the cassette can be replayed with any server URL.
+type CassetteRequest struct {
+	Method  string      `json:"method"`
+	Url     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+type CassetteResponse struct {
+	Status   int         `json:"status"`
+	Headers  http.Header `json:"headers,omitempty"`
+	Body     string      `json:"body,omitempty"`
+	Encoding string      `json:"encoding,omitempty"`
+}
+
+// Returned when a request being replayed is not recorded in the cassette. Such requests are not retried.
+var ErrNoRecordedResponse = errors.New("no recorded response found")
+
+var (
+	cassetteMode string
+	cassetteFile *os.File
+	// Recorded interactions being replayed, and whether each of them is already replayed.
+	replayInteractions []CassetteInteraction
+	replayed           []bool
+	cassetteLock       sync.Mutex
+)
+
+// Records all the requests sent to the server and their responses in a cassette file at the given path.
+func StartRecording(filePath string) error {
+
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0600)
+	if err != nil {
+		return fmt.Errorf("error creating the cassette file: %w", err)
+	}
+	header := fmt.Sprintf(`{"version":%d,"interactions":[`, CASSETTE_VERSION)
+	if _, err := file.WriteString(header + CASSETTE_CLOSING); err != nil {
+		file.Close()
+		return fmt.Errorf("error writing the cassette file: %w", err)
+	}
+
+	cassetteLock.Lock()
+	cassetteMode = CASSETTE_MODE_RECORD
+	cassetteFile = file
+	cassetteLock.Unlock()
+	resetHTTPClient()
+	return nil
+}
+
+// Replays the responses recorded in the cassette file at the given path instead of sending the requests to the server.
+func StartReplay(filePath string) error {
+
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("error reading the cassette file: %w", err)
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return fmt.Errorf("error parsing the cassette file: %w", err)
+	}
+	if cassette.Version != CASSETTE_VERSION {
+		return fmt.Errorf("unsupported cassette version: %d", cassette.Version)
+	}
+
+	cassetteLock.Lock()
+	cassetteMode = CASSETTE_MODE_REPLAY
+	replayInteractions = cassette.Interactions
+	replayed = make([]bool, len(cassette.Interactions))
+	cassetteLock.Unlock()
+	resetHTTPClient()
+	return nil
+}
+
+// Stops recording or replaying the requests.
+func StopCassette() error {
+
+	cassetteLock.Lock()
+	var err error
+	if cassetteFile != nil {
+		err = cassetteFile.Close()
+	}
+	cassetteMode = ""
+	cassetteFile = nil
+	replayInteractions = nil
+	replayed = nil
+	cassetteLock.Unlock()
+	resetHTTPClient()
+	return err
+}
+
+func getCassetteMode() string {
+
+	cassetteLock.Lock()
+	defer cassetteLock.Unlock()
+
+	return cassetteMode
+}
+
+// Transport that records the requests sent through the given transport, or replays the recorded responses.
+type cassetteTransport struct {
+	mode string
+	next http.RoundTripper
+}
+
+func (transport *cassetteTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+
+	var requestBody []byte
+	if request.Body != nil {
+		var err error
+		requestBody, err = ioutil.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	recordedRequest := newCassetteRequest(request, requestBody)
+
+	if transport.mode == CASSETTE_MODE_REPLAY {
+		interaction, err := findReplayInteraction(recordedRequest)
+		if err != nil {
+			return nil, err
+		}
+		return newReplayResponse(request, interaction.Response)
+	}
+
+	outgoing := *request
+	if requestBody != nil {
+		outgoing.Body = ioutil.NopCloser(bytes.NewReader(requestBody))
+	}
+	resp, err := transport.next.RoundTrip(&outgoing)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := CassetteInteraction{
+		Request:  recordedRequest,
+		Response: newCassetteResponse(resp, responseBody),
+	}
+	if err := writeCassetteInteraction(interaction); err != nil {
+		PrintLog(LogLevelWarn, UtilsResourceWrapper, "", "Error writing the cassette file: "+err.Error())
+	}
+	return resp, nil
+}
+
+// Records a request with the auth headers and the secrets in the body scrubbed.
+func newCassetteRequest(request *http.Request, body []byte) CassetteRequest {
+
+	recorded := CassetteRequest{
+		Method:  request.Method,
+		Url:     request.URL.RequestURI(),
+		Headers: scrubHeaders(request.Header),
+	}
+	if body != nil {
+		recorded.Body = normalizeRequestBody(request.Header.Get("Content-Type"), string(body))
+	}
+	if boundary := getMultipartBoundary(request.Header.Get("Content-Type")); boundary != "" {
+		recorded.Headers.Set("Content-Type", strings.Replace(request.Header.Get("Content-Type"), boundary,
+			CASSETTE_BOUNDARY, 1))
+	}
+	return recorded
+}
+
+func newCassetteResponse(resp *http.Response, body []byte) CassetteResponse {
+
+	recorded := CassetteResponse{
+		Status:  resp.StatusCode,
+		Headers: scrubHeaders(resp.Header),
+	}
+	if utf8.Valid(body) {
+		recorded.Body = RedactSecrets(string(body))
+	} else {
+		recorded.Body = base64.StdEncoding.EncodeToString(body)
+		recorded.Encoding = BODY_ENCODING_BASE64
+	}
+	return recorded
+}
+
+func newReplayResponse(request *http.Request, recorded CassetteResponse) (*http.Response, error) {
+
+	body := []byte(recorded.Body)
+	if recorded.Encoding == BODY_ENCODING_BASE64 {
+		var err error
+		if body, err = base64.StdEncoding.DecodeString(recorded.Body); err != nil {
+			return nil, fmt.Errorf("error decoding the recorded response body: %w", err)
+		}
+	}
+	headers := recorded.Headers
+	if headers == nil {
+		headers = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}, nil
+}
+
+func scrubHeaders(header http.Header) http.Header {
+
+	scrubbed := http.Header{}
+	for name, values := range header {
+		for _, value := range values {
+			if redactedHeaders[http.CanonicalHeaderKey(name)] {
+				value = REDACTED_VALUE
+			}
+			scrubbed.Add(name, value)
+		}
+	}
+	return scrubbed
+}
+
+// Normalizes a request body so that it matches the recorded body of the same request. The secrets are redacted
+// and the random multipart boundaries are replaced.
+func normalizeRequestBody(contentType, body string) string {
+
+	if boundary := getMultipartBoundary(contentType); boundary != "" {
+		body = strings.Replace(body, boundary, CASSETTE_BOUNDARY, -1)
+	}
+	return RedactSecrets(body)
+}
+
+func getMultipartBoundary(contentType string) string {
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") {
+		return ""
+	}
+	return params["boundary"]
+}
+
+// Finds the recorded interaction of a request, matched on the method, path, query and body. Identical requests
+// are replayed in the recorded order, and the last one is replayed again once all of them are replayed.
+func findReplayInteraction(request CassetteRequest) (*CassetteInteraction, error) {
+
+	cassetteLock.Lock()
+	defer cassetteLock.Unlock()
+
+	lastMatch := -1
+	for i, interaction := range replayInteractions {
+		if !matchesCassetteRequest(interaction.Request, request) {
+			continue
+		}
+		if !replayed[i] {
+			replayed[i] = true
+			return &replayInteractions[i], nil
+		}
+		lastMatch = i
+	}
+	if lastMatch >= 0 {
+		return &replayInteractions[lastMatch], nil
+	}
+	return nil, fmt.Errorf("%w for %s %s", ErrNoRecordedResponse, request.Method, request.Url)
+}
+
+func matchesCassetteRequest(recorded, request CassetteRequest) bool {
+
+	if recorded.Method != request.Method || !matchesRequestUrl(recorded.Url, request.Url) {
+		return false
+	}
+	return compactJson(recorded.Body) == compactJson(request.Body)
+}
+
+// Matches the path and the query parameters of the URLs, regardless of the order of the query parameters.
+func matchesRequestUrl(recordedUrl, requestUrl string) bool {
+
+	recordedPath, recordedQuery := splitRequestUri(recordedUrl)
+	requestPath, requestQuery := splitRequestUri(requestUrl)
+	return recordedPath == requestPath && recordedQuery == requestQuery
+}
+
+func splitRequestUri(requestUri string) (string, string) {
+
+	parts := strings.SplitN(requestUri, "?", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	query := strings.Split(parts[1], "&")
+	sort.Strings(query)
+	return parts[0], strings.Join(query, "&")
+}
+
+// Compacts a JSON body, so that the formatting of the body does not affect matching. Other bodies are unchanged.
+func compactJson(body string) string {
+
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, []byte(body)); err != nil {
+		return body
+	}
+	return compacted.String()
+}
+
+func writeCassetteInteraction(interaction CassetteInteraction) error {
+
+	data, err := json.Marshal(interaction)
+	if err != nil {
+		return err
+	}
+
+	cassetteLock.Lock()
+	defer cassetteLock.Unlock()
+
+	if cassetteFile == nil {
+		return nil
+	}
+	return appendJsonArrayEntry(cassetteFile, data, CASSETTE_CLOSING)
+}
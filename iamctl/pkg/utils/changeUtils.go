@@ -0,0 +1,218 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Returns the files changed in the input directory since the given git reference, relative to the input directory.
+// The uncommitted and untracked files are included, and renamed files are listed with both the old and new paths,
+// so that the resources of the old paths are deleted.
+func GetChangedFilesSince(inputDirPath, gitRef string) ([]string, error) {
+
+	changed, err := runGitCommand(inputDirPath, "diff", "--name-only", "--no-renames", "--relative", gitRef, "--")
+	if err != nil {
+		return nil, fmt.Errorf("error listing the files changed since %s: %w", gitRef, err)
+	}
+	untracked, err := runGitCommand(inputDirPath, "ls-files", "--others", "--exclude-standard")
+	if err != nil {
+		return nil, fmt.Errorf("error listing the untracked files: %w", err)
+	}
+	return append(changed, untracked...), nil
+}
+
+func runGitCommand(dirPath string, args ...string) ([]string, error) {
+
+	var stdout, stderr bytes.Buffer
+	command := exec.Command("git", append([]string{"-C", dirPath}, args...)...)
+	command.Stdout = &stdout
+	command.Stderr = &stderr
+	if err := command.Run(); err != nil {
+		return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var lines []string
+	for _, line := range strings.Split(stdout.String(), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
+
+// Maps the changed files, given relative to the input directory, to the resources they belong to, using the
+// directory layout of the exported resources. Returns the names of the changed resources of each resource type.
+// A resource type without resource names is changed as a whole, as its resources cannot be selected by name.
+// Files outside the resource type directories, such as the configs, are ignored.
+func ResolveChangedResources(changedFiles []string) map[ResourceType][]string {
+
+	selection := make(map[ResourceType][]string)
+	for _, changedFile := range changedFiles {
+		changedFile = filepath.ToSlash(filepath.Clean(changedFile))
+		if _, err := FormatFromExtension(filepath.Ext(changedFile)); err != nil {
+			continue
+		}
+		pathParts := strings.SplitN(strings.TrimSuffix(changedFile, filepath.Ext(changedFile)), "/", 2)
+		if len(pathParts) < 2 || !isResourceTypeDir(pathParts[0]) {
+			continue
+		}
+		resourceType, resourceName := ResolveResourceFromPath(ResourceType(pathParts[0]), pathParts[1])
+		addToSelection(selection, resourceType, resourceName)
+	}
+	return selection
+}
+
+// Adds the local resources that directly or indirectly depend on the selected resources to the selection, so that
+// the references to the changed resources are updated as well.
+func AddDependentResources(inputDirPath string, selection map[ResourceType][]string) error {
+
+	graph, err := BuildDependencyGraph(inputDirPath)
+	if err != nil {
+		return err
+	}
+	// Sorted so that the resources are added in the same order in every run.
+	resources := make([]ResourceKey, 0, len(graph.ResourceDependencies))
+	for resource := range graph.ResourceDependencies {
+		resources = append(resources, resource)
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].ResourceType != resources[j].ResourceType {
+			return resources[i].ResourceType < resources[j].ResourceType
+		}
+		return resources[i].Name < resources[j].Name
+	})
+
+	for added := true; added; {
+		added = false
+		for _, resource := range resources {
+			if isSelected(selection, resource) {
+				continue
+			}
+			for _, dependency := range graph.ResourceDependencies[resource] {
+				if isSelected(selection, dependency) {
+					addToSelection(selection, resource.ResourceType, resource.Name)
+					added = true
+					break
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Removes the resources excluded by the tool configs from the selection, so that the changed resources are
+// processed only if they would be processed when importing all the resources.
+func RemoveExcludedResources(selection map[ResourceType][]string) {
+
+	for resourceType, names := range selection {
+		parentType := resourceType
+		if resourceType == BRANDING_PREFERENCES || resourceType == CUSTOM_TEXTS {
+			parentType = BRANDING
+		}
+		if isResourceTypeExcluded(parentType) {
+			delete(selection, resourceType)
+			continue
+		}
+		if names == nil {
+			continue
+		}
+		var included []string
+		for _, name := range names {
+			if !IsResourceExcluded(name, *getResourceToolConfigsRef(resourceType)) {
+				included = append(included, name)
+			}
+		}
+		if len(included) == 0 {
+			delete(selection, resourceType)
+		} else {
+			selection[resourceType] = included
+		}
+	}
+}
+
+// Returns the resource types to be processed to import the selected resources, in the processing order.
+func GetSelectedResourceTypes(selection map[ResourceType][]string) []ResourceType {
+
+	var resourceTypes []ResourceType
+	for _, resourceType := range ResourceOrder {
+		if resourceType == BRANDING {
+			_, preferencesSelected := selection[BRANDING_PREFERENCES]
+			_, customTextsSelected := selection[CUSTOM_TEXTS]
+			if preferencesSelected || customTextsSelected {
+				resourceTypes = append(resourceTypes, resourceType)
+			}
+		} else if _, selected := selection[resourceType]; selected {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+	return resourceTypes
+}
+
+// Adds a resource to the selection. The resource type is selected as a whole if its resources cannot be
+// selected by name.
+func addToSelection(selection map[ResourceType][]string, resourceType ResourceType, resourceName string) {
+
+	names, selected := selection[resourceType]
+	if !IsResourceSelectionSupported(resourceType) {
+		selection[resourceType] = nil
+		return
+	}
+	if selected && names == nil {
+		return
+	}
+	for _, name := range names {
+		if name == resourceName {
+			return
+		}
+	}
+	selection[resourceType] = append(names, resourceName)
+}
+
+func isSelected(selection map[ResourceType][]string, resource ResourceKey) bool {
+
+	names, selected := selection[resource.ResourceType]
+	if !selected {
+		return false
+	}
+	if names == nil {
+		return true
+	}
+	for _, name := range names {
+		if name == resource.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func isResourceTypeDir(dirName string) bool {
+
+	for _, resourceType := range ResourceOrder {
+		if dirName == resourceType.String() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+const CHECKPOINT_FILE_NAME = ".iamctl-checkpoint.json"
+
+// Progress of an import, persisted so that a failed import can be resumed.
+type Checkpoint struct {
+	ServerUrl              string                    `json:"serverUrl"`
+	TenantDomain           string                    `json:"tenantDomain"`
+	Organization           string                    `json:"organization,omitempty"`
+	UpdatedAt              time.Time                 `json:"updatedAt"`
+	CompletedResourceTypes []ResourceType            `json:"completedResourceTypes"`
+	CompletedResources     map[ResourceType][]string `json:"completedResources"`
+	IdentifierMap          ResourceIdentifierMap     `json:"identifierMap"`
+}
+
+var (
+	checkpoint         *Checkpoint
+	checkpointFilePath string
+	checkpointLock     sync.Mutex
+)
+
+// Starts recording the progress of an import to the given checkpoint file. If resume is true, the progress recorded
+// in the file is restored: the identifier mappings are added to the resource identifier map and the completed
+// resources are excluded from the import. Returns the resource types completed in the previous run.
+func InitCheckpoint(filePath string, resume bool) ([]ResourceType, error) {
+
+	checkpointLock.Lock()
+	defer checkpointLock.Unlock()
+
+	checkpointFilePath = filePath
+	checkpoint = newCheckpoint()
+	if !resume {
+		return nil, nil
+	}
+
+	previous, err := loadCheckpoint(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if previous.ServerUrl != checkpoint.ServerUrl || previous.TenantDomain != checkpoint.TenantDomain ||
+		previous.Organization != checkpoint.Organization {
+		return nil, fmt.Errorf("checkpoint was recorded for a different target environment: %s (tenant: %s)",
+			previous.ServerUrl, previous.TenantDomain)
+	}
+
+	for resourceType, resourceNames := range previous.CompletedResources {
+		if err := ExcludeResources(resourceType, resourceNames); err != nil {
+			return nil, err
+		}
+	}
+	restoreIdentifierMap(previous.IdentifierMap)
+	checkpoint.CompletedResourceTypes = previous.CompletedResourceTypes
+	checkpoint.CompletedResources = previous.CompletedResources
+	return previous.CompletedResourceTypes, nil
+}
+
+// Records a resource imported successfully.
+func MarkResourceCompleted(resourceType ResourceType, resourceName string) {
+
+	checkpointLock.Lock()
+	defer checkpointLock.Unlock()
+
+	if checkpoint == nil {
+		return
+	}
+	checkpoint.CompletedResources[resourceType] = append(checkpoint.CompletedResources[resourceType], resourceName)
+	saveCheckpoint()
+}
+
+// Records a resource type imported without any failures.
+func MarkResourceTypeCompleted(resourceType ResourceType) {
+
+	checkpointLock.Lock()
+	defer checkpointLock.Unlock()
+
+	if checkpoint == nil {
+		return
+	}
+	checkpoint.CompletedResourceTypes = append(checkpoint.CompletedResourceTypes, resourceType)
+	saveCheckpoint()
+}
+
+// Removes the checkpoint file once the import is completed.
+func RemoveCheckpoint() {
+
+	checkpointLock.Lock()
+	defer checkpointLock.Unlock()
+
+	if checkpoint == nil || DryRun {
+		return
+	}
+	if err := os.Remove(checkpointFilePath); err != nil && !os.IsNotExist(err) {
+		PrintLog(LogLevelWarn, UtilsResourceWrapper, "", fmt.Sprintf("Error removing the checkpoint file: %s", err))
+	}
+	checkpoint = nil
+}
+
+func newCheckpoint() *Checkpoint {
+
+	return &Checkpoint{
+		ServerUrl:          SERVER_CONFIGS.ServerUrl,
+		TenantDomain:       SERVER_CONFIGS.TenantDomain,
+		Organization:       SERVER_CONFIGS.Organization,
+		CompletedResources: make(map[ResourceType][]string),
+	}
+}
+
+func loadCheckpoint(filePath string) (*Checkpoint, error) {
+
+	fileBytes, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the checkpoint file: %w", err)
+	}
+	var loaded Checkpoint
+	if err := json.Unmarshal(fileBytes, &loaded); err != nil {
+		return nil, fmt.Errorf("error parsing the checkpoint file: %w", err)
+	}
+	return &loaded, nil
+}
+
+// Writes the checkpoint to a temporary file first, so that the checkpoint file is not corrupted if the tool is
+// stopped while writing it.
+func saveCheckpoint() {
+
+	if DryRun {
+		return
+	}
+	checkpoint.UpdatedAt = time.Now()
+	checkpoint.IdentifierMap = getIdentifierMapSnapshot()
+	data, err := json.MarshalIndent(checkpoint, "", "  ")
+	if err == nil {
+		tempFilePath := checkpointFilePath + ".tmp"
+		if err = ioutil.WriteFile(tempFilePath, data, 0600); err == nil {
+			err = os.Rename(tempFilePath, checkpointFilePath)
+		}
+	}
+	if err != nil {
+		PrintLog(LogLevelWarn, UtilsResourceWrapper, "", fmt.Sprintf("Error writing the checkpoint file: %s", err))
+	}
+}
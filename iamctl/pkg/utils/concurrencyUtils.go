@@ -0,0 +1,127 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Maximum number of resource types, and of resources within the resource types processed with ProcessResources,
+// processed concurrently.
+// Everything is processed sequentially by default.
+var Concurrency = 1
+
+// Slots shared by all the resources processed concurrently, so that the total number of resources
+// processed at a time does not exceed the concurrency, regardless of the number of resource types processed.
+var resourceSlots = make(chan struct{}, 1)
+
+func SetConcurrency(concurrency int) error {
+
+	if concurrency < 1 {
+		return fmt.Errorf("concurrency should be a positive number: %d", concurrency)
+	}
+	Concurrency = concurrency
+	resourceSlots = make(chan struct{}, concurrency)
+	return nil
+}
+
+// Processes the resources of a resource type concurrently, limited by the concurrency. Blocks until all the resources
+// are processed. Should not be nested, since the outer resources hold the slots needed by the inner ones.
+// No new resources are started once the run is interrupted, while the resources being processed are completed.
+func ProcessResources(count int, process func(index int)) {
+
+	if Concurrency <= 1 || count <= 1 {
+		for i := 0; i < count && !IsInterrupted(); i++ {
+			process(i)
+		}
+		return
+	}
+
+	slots := resourceSlots
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		slots <- struct{}{}
+		if IsInterrupted() {
+			<-slots
+			break
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			process(index)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// Processes the given resource types concurrently, limited by the concurrency. A resource type is started only after
+// the resource types it depends on are processed, and the resource types are started in the given order.
+// No new resource types are started once shouldStop returns true. Returns the resource types that were not processed.
+func ProcessResourceTypes(resourceTypes []ResourceType, process func(ResourceType), shouldStop func() bool) []ResourceType {
+
+	pending := make(map[ResourceType]bool)
+	for _, resourceType := range resourceTypes {
+		pending[resourceType] = true
+	}
+	running := make(map[ResourceType]bool)
+	done := make(chan ResourceType)
+
+	for {
+		if !shouldStop() {
+			for _, resourceType := range resourceTypes {
+				if len(running) >= Concurrency {
+					break
+				}
+				if !pending[resourceType] || !isResourceTypeReady(resourceType, pending, running) {
+					continue
+				}
+				delete(pending, resourceType)
+				running[resourceType] = true
+				go func(resourceType ResourceType) {
+					process(resourceType)
+					done <- resourceType
+				}(resourceType)
+			}
+		}
+		if len(running) == 0 {
+			break
+		}
+		delete(running, <-done)
+	}
+
+	var unprocessed []ResourceType
+	for _, resourceType := range resourceTypes {
+		if pending[resourceType] {
+			unprocessed = append(unprocessed, resourceType)
+		}
+	}
+	return unprocessed
+}
+
+func isResourceTypeReady(resourceType ResourceType, pending, running map[ResourceType]bool) bool {
+
+	for _, dependency := range GetResourceTypeDependencies(resourceType) {
+		if pending[dependency] || running[dependency] {
+			return false
+		}
+	}
+	return true
+}
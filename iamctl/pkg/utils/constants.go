@@ -61,6 +61,20 @@ const SERVER_VERSION_CONFIG = "SERVER_VERSION"
 const TOOL_CONFIG_PATH = "TOOL_CONFIG_PATH"
 const KEYWORD_CONFIG_PATH = "KEYWORD_CONFIG_PATH"
 const TOKEN_CONFIG = "TOKEN"
+const INSECURE_SKIP_VERIFY_CONFIG = "INSECURE_SKIP_VERIFY"
+const CA_CERT_PATH_CONFIG = "CA_CERT_PATH"
+const CLIENT_CERT_PATH_CONFIG = "CLIENT_CERT_PATH"
+const CLIENT_KEY_PATH_CONFIG = "CLIENT_KEY_PATH"
+const PINNED_CERTIFICATES_CONFIG = "PINNED_CERTIFICATES"
+const CLIENT_AUTH_METHOD_CONFIG = "CLIENT_AUTH_METHOD"
+const PRIVATE_KEY_PATH_CONFIG = "PRIVATE_KEY_PATH"
+const PRIVATE_KEY_ID_CONFIG = "PRIVATE_KEY_ID"
+const GRANT_TYPE_CONFIG = "GRANT_TYPE"
+const SUBJECT_TOKEN_CONFIG = "SUBJECT_TOKEN"
+const SUBJECT_TOKEN_FILE_CONFIG = "SUBJECT_TOKEN_FILE"
+const SUBJECT_TOKEN_TYPE_CONFIG = "SUBJECT_TOKEN_TYPE"
+const ACCESS_TOKEN_CONFIG = "ACCESS_TOKEN"
+const ACCESS_TOKEN_FILE_CONFIG = "ACCESS_TOKEN_FILE"
 
 // Resource types
 type ResourceType string
@@ -326,6 +340,32 @@ var RESOURCE_REFERENCE_METADATA = map[ResourceType][]ResourceReferenceMeta{
 	},
 }
 
+type ResourceDependencyMeta struct {
+	DependencyType  ResourceType // The resource type the resource depends on
+	DependencyPaths []string     // Paths to where the names of the dependencies appear in the local resource files
+}
+
+// Maps resource types to the resources they depend on, by the names used in the local resource files.
+var RESOURCE_DEPENDENCY_METADATA = map[ResourceType][]ResourceDependencyMeta{
+	APPLICATIONS: {
+		{DependencyType: IDENTITY_PROVIDERS, DependencyPaths: []string{
+			"localAndOutBoundAuthenticationConfig.authenticationSteps.[stepOrder=all_items].federatedIdentityProviders.[identityProviderName=all_items].identityProviderName",
+			"outboundProvisioningConfig.provisioningIdentityProviders.[identityProviderName=all_items].identityProviderName",
+			"authenticationSequence.steps.[id=all_items].options.[authenticator=all_items].idp",
+			"provisioningConfigurations.outboundProvisioningIdps.[idp=all_items].idp",
+		}},
+	},
+	APPLICATION_AUTHORIZED_APIS: {
+		{DependencyType: API_RESOURCES, DependencyPaths: []string{"[identifier=all_items].identifier"}},
+	},
+	ROLES: {
+		{DependencyType: APPLICATIONS, DependencyPaths: []string{"audience.display"}},
+	},
+	WORKFLOWS: {
+		{DependencyType: ROLES, DependencyPaths: []string{"template.steps.[step=all_items].options.[entity=roles].values"}},
+	},
+}
+
 // Array field paths for each resource type
 var oidcScopeArrayFields = []string{
 
@@ -406,3 +446,13 @@ const (
 	XML_ROOT_APPLICATION          = "ServiceProvider"
 	XML_ROOT_CERTIFICATE          = "Certificate"
 )
+
+// Exit codes of the CLI commands
+const (
+	EXIT_CODE_SUCCESS               = 0
+	EXIT_CODE_CONFIG_FAILURE        = 2
+	EXIT_CODE_PARTIAL_FAILURE       = 3
+	EXIT_CODE_RESOURCE_TYPE_FAILURE = 4
+	EXIT_CODE_DRIFT_DETECTED        = 5
+	EXIT_CODE_INTERRUPTED           = 130
+)
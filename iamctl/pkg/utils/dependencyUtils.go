@@ -0,0 +1,375 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	GRAPH_FORMAT_DOT     = "dot"
+	GRAPH_FORMAT_MERMAID = "mermaid"
+)
+
+// Identifies a single local resource by its resource type and name.
+type ResourceKey struct {
+	ResourceType ResourceType
+	Name         string
+}
+
+type ResourceDependencyGraph struct {
+	ResourceTypes        []ResourceTypeDependency
+	ResourceDependencies map[ResourceKey][]ResourceKey
+}
+
+// Sorts the resource types so that each resource type comes after the resource types it depends on.
+// Resource types without dependencies between them keep the order they are declared in.
+func SortResourceTypes(dependencies []ResourceTypeDependency) ([]ResourceType, error) {
+
+	declared := make(map[ResourceType]bool)
+	for _, dependency := range dependencies {
+		if declared[dependency.ResourceType] {
+			return nil, fmt.Errorf("resource type %s is declared more than once", dependency.ResourceType)
+		}
+		declared[dependency.ResourceType] = true
+	}
+
+	for _, dependency := range dependencies {
+		for _, dependsOn := range dependency.DependsOn {
+			if !declared[dependsOn] {
+				return nil, fmt.Errorf("resource type %s depends on the undeclared resource type %s", dependency.ResourceType, dependsOn)
+			}
+		}
+	}
+
+	sorted := make([]ResourceType, 0, len(dependencies))
+	processed := make(map[ResourceType]bool)
+	for len(sorted) < len(dependencies) {
+		progressed := false
+		for _, dependency := range dependencies {
+			if processed[dependency.ResourceType] || !areDependenciesProcessed(dependency.DependsOn, processed) {
+				continue
+			}
+			sorted = append(sorted, dependency.ResourceType)
+			processed[dependency.ResourceType] = true
+			progressed = true
+			break
+		}
+		if !progressed {
+			var cyclic []string
+			for _, dependency := range dependencies {
+				if !processed[dependency.ResourceType] {
+					cyclic = append(cyclic, dependency.ResourceType.String())
+				}
+			}
+			return nil, fmt.Errorf("dependency cycle detected between the resource types: %s", strings.Join(cyclic, ", "))
+		}
+	}
+	return sorted, nil
+}
+
+func areDependenciesProcessed(dependsOn []ResourceType, processed map[ResourceType]bool) bool {
+
+	for _, resourceType := range dependsOn {
+		if !processed[resourceType] {
+			return false
+		}
+	}
+	return true
+}
+
+// Sorts the declared resource types when the package is initialized. An invalid declaration is a programming error
+// caught by the tests, hence it panics.
+func mustSortResourceTypes(dependencies []ResourceTypeDependency) []ResourceType {
+
+	sorted, err := SortResourceTypes(dependencies)
+	if err != nil {
+		panic(fmt.Sprintf("invalid RESOURCE_TYPE_DEPENDENCIES: %s", err))
+	}
+	return sorted
+}
+
+// Returns the resource types the given resource type directly depends on.
+func GetResourceTypeDependencies(resourceType ResourceType) []ResourceType {
+
+	for _, dependency := range RESOURCE_TYPE_DEPENDENCIES {
+		if dependency.ResourceType == resourceType {
+			return dependency.DependsOn
+		}
+	}
+	return nil
+}
+
+// Returns the resource types that directly or indirectly depend on the given resource type, in the processing order.
+func GetDependentResourceTypes(resourceType ResourceType) []ResourceType {
+
+	dependents := map[ResourceType]bool{resourceType: true}
+	var result []ResourceType
+	for _, current := range ResourceOrder {
+		for _, dependsOn := range GetResourceTypeDependencies(current) {
+			if dependents[dependsOn] && !dependents[current] {
+				dependents[current] = true
+				result = append(result, current)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// Resolves the local resources the given resource directly or indirectly depends on.
+// Returns the names of the resources to be processed for each resource type, including the given resource.
+// Only the dependencies that exist in the input directory are returned, since the others are expected to be
+// already available in the target environment.
+func ResolveResourceDependencies(inputDirPath string, resourceType ResourceType, resourceName string) (map[ResourceType][]string, error) {
+
+	selection := map[ResourceType][]string{resourceType: {resourceName}}
+	visited := map[ResourceKey]bool{{resourceType, resourceName}: true}
+	pending := []ResourceKey{{resourceType, resourceName}}
+
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+
+		dependencies, err := GetLocalResourceDependencies(inputDirPath, current.ResourceType, current.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, dependency := range dependencies {
+			if visited[dependency] {
+				continue
+			}
+			visited[dependency] = true
+			selection[dependency.ResourceType] = append(selection[dependency.ResourceType], dependency.Name)
+			pending = append(pending, dependency)
+		}
+	}
+	return selection, nil
+}
+
+// Returns the local resources the given resource directly depends on.
+func GetLocalResourceDependencies(inputDirPath string, resourceType ResourceType, resourceName string) ([]ResourceKey, error) {
+
+	if len(RESOURCE_DEPENDENCY_METADATA[resourceType]) == 0 && resourceType != APPLICATIONS {
+		return nil, nil
+	}
+	resourceDir := filepath.Join(inputDirPath, resourceType.String())
+	filePath, err := findLocalResourceFile(resourceDir, resourceType, resourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	dependencies, err := getFileDependencies(inputDirPath, filePath, resourceType, resourceType, resourceName)
+	if err != nil {
+		return nil, err
+	}
+	if resourceType == APPLICATIONS {
+		authorizedApisDir := filepath.Join(resourceDir, APPLICATION_AUTHORIZED_APIS.String())
+		if authorizedApisFile, err := findLocalResourceFile(authorizedApisDir, APPLICATIONS, resourceName); err == nil {
+			apiDependencies, err := getFileDependencies(inputDirPath, authorizedApisFile, APPLICATION_AUTHORIZED_APIS,
+				resourceType, resourceName)
+			if err != nil {
+				return nil, err
+			}
+			dependencies = append(dependencies, apiDependencies...)
+		}
+	}
+	return dependencies, nil
+}
+
+func getFileDependencies(inputDirPath, filePath string, configType, resourceType ResourceType,
+	resourceName string) ([]ResourceKey, error) {
+
+	data, err := LoadResourceFile(filePath, GetResourceKeywordMapping(resourceType, resourceName), resourceType)
+	if err != nil {
+		return nil, fmt.Errorf("error loading the local %s %s: %w", resourceType, resourceName, err)
+	}
+
+	var dependencies []ResourceKey
+	added := make(map[ResourceKey]bool)
+	for _, dependencyMeta := range RESOURCE_DEPENDENCY_METADATA[configType] {
+		dependencyDir := filepath.Join(inputDirPath, dependencyMeta.DependencyType.String())
+		for _, path := range dependencyMeta.DependencyPaths {
+			// Paths that do not match the structure of the local file are not applicable to it.
+			concretePaths, err := ResolveAllItemsPaths(data, path)
+			if err != nil {
+				PrintLog(LogLevelDebug, resourceType, resourceName, fmt.Sprintf("Skipping the dependency path %s: %s", path, err))
+				continue
+			}
+			for _, concretePath := range concretePaths {
+				for _, name := range getDependencyNames(getRawValue(data, concretePath)) {
+					key := ResourceKey{dependencyMeta.DependencyType, name}
+					if added[key] {
+						continue
+					}
+					if _, err := findLocalResourceFile(dependencyDir, key.ResourceType, name); err != nil {
+						continue
+					}
+					added[key] = true
+					dependencies = append(dependencies, key)
+				}
+			}
+		}
+	}
+	return dependencies, nil
+}
+
+func getDependencyNames(value interface{}) []string {
+
+	switch v := value.(type) {
+	case string:
+		if v != "" {
+			return []string{v}
+		}
+	case []interface{}:
+		var names []string
+		for _, elem := range v {
+			if name, ok := elem.(string); ok && name != "" {
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+	return nil
+}
+
+// Finds the local file of a resource in the given resource type directory.
+func findLocalResourceFile(resourceDir string, resourceType ResourceType, resourceName string) (string, error) {
+
+	for _, format := range []Format{FormatYAML, FormatJSON, FormatXML} {
+		filePath := GetExportedFilePath(resourceDir, GetFileNameFromResourceName(resourceType, resourceName), format)
+		if info, err := os.Stat(filePath); err == nil && !info.IsDir() {
+			return filePath, nil
+		}
+	}
+	return "", fmt.Errorf("local file not found for %s %s", resourceType, resourceName)
+}
+
+// Builds the dependency graph of the resource types. If an input directory is given, the dependencies between the
+// local resources are added as well.
+func BuildDependencyGraph(inputDirPath string) (ResourceDependencyGraph, error) {
+
+	graph := ResourceDependencyGraph{ResourceTypes: RESOURCE_TYPE_DEPENDENCIES}
+	if inputDirPath == "" {
+		return graph, nil
+	}
+
+	graph.ResourceDependencies = make(map[ResourceKey][]ResourceKey)
+	for _, resourceType := range ResourceOrder {
+		if _, exists := RESOURCE_DEPENDENCY_METADATA[resourceType]; !exists {
+			continue
+		}
+		files, err := ListResourceFiles(filepath.Join(inputDirPath, resourceType.String()))
+		if err != nil {
+			return graph, fmt.Errorf("error reading the local %s: %w", resourceType, err)
+		}
+		for relativePath := range files {
+			if strings.Contains(relativePath, "/") {
+				continue
+			}
+			_, resourceName := ResolveResourceFromPath(resourceType, relativePath)
+			dependencies, err := GetLocalResourceDependencies(inputDirPath, resourceType, resourceName)
+			if err != nil {
+				return graph, err
+			}
+			if len(dependencies) > 0 {
+				graph.ResourceDependencies[ResourceKey{resourceType, resourceName}] = dependencies
+			}
+		}
+	}
+	return graph, nil
+}
+
+// Renders the dependency graph in the given format. The edges point from a resource to its dependencies.
+func RenderDependencyGraph(graph ResourceDependencyGraph, format string) (string, error) {
+
+	var nodes, edges []string
+	nodeIds := make(map[ResourceKey]string)
+	getNodeId := func(key ResourceKey) string {
+		if id, exists := nodeIds[key]; exists {
+			return id
+		}
+		label := key.ResourceType.String()
+		if key.Name != "" {
+			label = fmt.Sprintf("%s: %s", key.ResourceType, key.Name)
+		}
+		id := fmt.Sprintf("n%d", len(nodeIds))
+		nodeIds[key] = id
+		switch format {
+		case GRAPH_FORMAT_DOT:
+			nodes = append(nodes, fmt.Sprintf("  %s [label=%q];", id, label))
+		case GRAPH_FORMAT_MERMAID:
+			nodes = append(nodes, fmt.Sprintf("  %s[\"%s\"]", id, strings.ReplaceAll(label, "\"", "#quot;")))
+		}
+		return id
+	}
+	addEdge := func(from, to ResourceKey) {
+		fromId, toId := getNodeId(from), getNodeId(to)
+		switch format {
+		case GRAPH_FORMAT_DOT:
+			edges = append(edges, fmt.Sprintf("  %s -> %s;", fromId, toId))
+		case GRAPH_FORMAT_MERMAID:
+			edges = append(edges, fmt.Sprintf("  %s --> %s", fromId, toId))
+		}
+	}
+
+	if format != GRAPH_FORMAT_DOT && format != GRAPH_FORMAT_MERMAID {
+		return "", fmt.Errorf("unsupported graph format: %s", format)
+	}
+
+	for _, dependency := range graph.ResourceTypes {
+		getNodeId(ResourceKey{ResourceType: dependency.ResourceType})
+		for _, dependsOn := range dependency.DependsOn {
+			addEdge(ResourceKey{ResourceType: dependency.ResourceType}, ResourceKey{ResourceType: dependsOn})
+		}
+	}
+
+	resources := make([]ResourceKey, 0, len(graph.ResourceDependencies))
+	for resource := range graph.ResourceDependencies {
+		resources = append(resources, resource)
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].ResourceType != resources[j].ResourceType {
+			return resources[i].ResourceType < resources[j].ResourceType
+		}
+		return resources[i].Name < resources[j].Name
+	})
+	for _, resource := range resources {
+		for _, dependency := range graph.ResourceDependencies[resource] {
+			addEdge(resource, dependency)
+		}
+	}
+
+	var builder strings.Builder
+	switch format {
+	case GRAPH_FORMAT_DOT:
+		builder.WriteString("digraph iamctl {\n")
+		builder.WriteString(strings.Join(append(nodes, edges...), "\n"))
+		builder.WriteString("\n}\n")
+	case GRAPH_FORMAT_MERMAID:
+		builder.WriteString("graph TD\n")
+		builder.WriteString(strings.Join(append(nodes, edges...), "\n"))
+		builder.WriteString("\n")
+	}
+	return builder.String(), nil
+}
@@ -0,0 +1,266 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type FieldDiff struct {
+	Path     string      `json:"path"`
+	Local    interface{} `json:"local"`
+	Deployed interface{} `json:"deployed"`
+}
+
+// Compares the local data of a resource with the deployed data and returns the fields that differ.
+// Fields masked as secrets on either side are not compared.
+func CompareResourceData(localData, deployedData interface{}, resourceType ResourceType) []FieldDiff {
+
+	diffs := compareValues(ConvertToStringKeyMap(localData), ConvertToStringKeyMap(deployedData), "", resourceType)
+	sort.SliceStable(diffs, func(i, j int) bool {
+		return diffs[i].Path < diffs[j].Path
+	})
+	return diffs
+}
+
+func compareValues(local, deployed interface{}, path string, resourceType ResourceType) []FieldDiff {
+
+	if isSecretMask(local) || isSecretMask(deployed) {
+		return nil
+	}
+
+	localMap, localIsMap := local.(map[string]interface{})
+	deployedMap, deployedIsMap := deployed.(map[string]interface{})
+	if localIsMap && deployedIsMap {
+		return compareMaps(localMap, deployedMap, path, resourceType)
+	}
+
+	localArray, localIsArray := local.([]interface{})
+	deployedArray, deployedIsArray := deployed.([]interface{})
+	if localIsArray && deployedIsArray {
+		return compareArrays(localArray, deployedArray, path, resourceType)
+	}
+
+	if isEmptyValue(local) && isEmptyValue(deployed) {
+		return nil
+	}
+	if !localIsMap && !localIsArray && !deployedIsMap && !deployedIsArray &&
+		fmt.Sprintf("%v", local) == fmt.Sprintf("%v", deployed) {
+		return nil
+	}
+	return []FieldDiff{{Path: path, Local: local, Deployed: deployed}}
+}
+
+func compareMaps(local, deployed map[string]interface{}, path string, resourceType ResourceType) []FieldDiff {
+
+	keys := make(map[string]struct{})
+	for key := range local {
+		keys[key] = struct{}{}
+	}
+	for key := range deployed {
+		keys[key] = struct{}{}
+	}
+
+	var diffs []FieldDiff
+	for key := range keys {
+		diffs = append(diffs, compareValues(local[key], deployed[key], extendPath(path, key), resourceType)...)
+	}
+	return diffs
+}
+
+func compareArrays(local, deployed []interface{}, path string, resourceType ResourceType) []FieldDiff {
+
+	localElements, localOk := indexArrayElements(local, path, resourceType)
+	deployedElements, deployedOk := indexArrayElements(deployed, path, resourceType)
+
+	// Arrays that cannot be matched element-wise are compared as a whole.
+	if !localOk || !deployedOk {
+		if len(local) != len(deployed) {
+			return []FieldDiff{{Path: path, Local: local, Deployed: deployed}}
+		}
+		for i := range local {
+			if len(compareValues(local[i], deployed[i], path, resourceType)) > 0 {
+				return []FieldDiff{{Path: path, Local: local, Deployed: deployed}}
+			}
+		}
+		return nil
+	}
+
+	keys := make(map[string]struct{})
+	for key := range localElements {
+		keys[key] = struct{}{}
+	}
+	for key := range deployedElements {
+		keys[key] = struct{}{}
+	}
+
+	var diffs []FieldDiff
+	for key := range keys {
+		diffs = append(diffs, compareValues(localElements[key], deployedElements[key], extendPath(path, key), resourceType)...)
+	}
+	return diffs
+}
+
+// Indexes the elements of an array of objects by the array identifiers of the resource type.
+// Returns false if the elements cannot be uniquely identified.
+func indexArrayElements(array []interface{}, path string, resourceType ResourceType) (map[string]interface{}, bool) {
+
+	arrayName := resourceType.String()
+	if path != "" {
+		pathKeys := GetPathKeys(path)
+		arrayName = pathKeys[len(pathKeys)-1]
+	}
+	identifier := GetArrayIdentifiers(resourceType)[arrayName]
+	if identifier == "" {
+		identifier = "name"
+	}
+
+	elements := make(map[string]interface{}, len(array))
+	for _, element := range array {
+		if _, ok := element.(map[string]interface{}); !ok {
+			return nil, false
+		}
+		identifierValue := GetValue(element, identifier)
+		if identifierValue == "" {
+			return nil, false
+		}
+		key := fmt.Sprintf("[%s=%s]", identifier, identifierValue)
+		if _, exists := elements[key]; exists {
+			return nil, false
+		}
+		elements[key] = element
+	}
+	return elements, true
+}
+
+func isSecretMask(value interface{}) bool {
+
+	strValue, ok := value.(string)
+	return ok && (strValue == SENSITIVE_FIELD_MASK || strValue == SENSITIVE_FIELD_MASK_WITHOUT_QUOTES)
+}
+
+func isEmptyValue(value interface{}) bool {
+
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// Loads a resource file after replacing the keywords with the given keyword mapping.
+func LoadResourceFile(filePath string, keywordMapping map[string]interface{}, resourceType ResourceType) (interface{}, error) {
+
+	format, err := FormatFromExtension(filepath.Ext(filePath))
+	if err != nil {
+		return nil, err
+	}
+	fileBytes, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file: %w", err)
+	}
+
+	fileContent := []byte(ReplaceKeywords(string(fileBytes), keywordMapping))
+	if format == FormatYAML {
+		fileContent = ReplaceTypeTags(fileContent)
+	}
+	data, err := Deserialize(fileContent, format, resourceType)
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing file: %w", err)
+	}
+	return ConvertToStringKeyMap(data), nil
+}
+
+// Lists the resource files in a resource type directory, including the files in sub directories.
+// Returns a map of the relative resource path without the file extension to the file path.
+func ListResourceFiles(dirPath string) (map[string]string, error) {
+
+	files := make(map[string]string)
+	if _, err := os.Stat(dirPath); os.IsNotExist(err) {
+		return files, nil
+	}
+
+	err := filepath.Walk(dirPath, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(info.Name(), ".") && filePath != dirPath {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() {
+			return nil
+		}
+		if _, err := FormatFromExtension(filepath.Ext(filePath)); err != nil {
+			return nil
+		}
+		relativePath, err := filepath.Rel(dirPath, filePath)
+		if err != nil {
+			return err
+		}
+		relativePath = filepath.ToSlash(strings.TrimSuffix(relativePath, filepath.Ext(relativePath)))
+		files[relativePath] = filePath
+		return nil
+	})
+	return files, err
+}
+
+// Resolves the resource type and the name of the resource that owns a file, given the path of the file
+// relative to the directory of the parent resource type.
+func ResolveResourceFromPath(resourceType ResourceType, relativePath string) (ResourceType, string) {
+
+	pathParts := strings.Split(filepath.ToSlash(relativePath), "/")
+	if resourceType == BRANDING && len(pathParts) > 1 {
+		resourceType = ResourceType(pathParts[0])
+		pathParts = pathParts[1:]
+	}
+	if len(pathParts) > 1 && pathParts[0] == APPLICATION_AUTHORIZED_APIS.String() {
+		return resourceType, pathParts[len(pathParts)-1]
+	}
+	return resourceType, GetResourceNameFromFileName(resourceType, pathParts[0])
+}
+
+func FormatDiffValue(value interface{}) string {
+
+	if value == nil {
+		return "<none>"
+	}
+	if strValue, ok := value.(string); ok {
+		return fmt.Sprintf("%q", strValue)
+	}
+	jsonValue, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(jsonValue)
+}
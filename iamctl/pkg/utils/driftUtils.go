@@ -0,0 +1,165 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+)
+
+// Differences between the deployed resources and the local resources. Added resources exist only in the
+// deployed environment, and removed resources exist only in the local directory.
+type DriftReport struct {
+	GeneratedAt   time.Time           `json:"generatedAt"`
+	ServerUrl     string              `json:"serverUrl"`
+	TenantDomain  string              `json:"tenantDomain"`
+	Organization  string              `json:"organization,omitempty"`
+	Drifted       bool                `json:"drifted"`
+	ResourceTypes []ResourceTypeDrift `json:"resourceTypes"`
+}
+
+type ResourceTypeDrift struct {
+	ResourceType ResourceType    `json:"resourceType"`
+	Added        []ResourceDrift `json:"added,omitempty"`
+	Removed      []ResourceDrift `json:"removed,omitempty"`
+	Changed      []ResourceDrift `json:"changed,omitempty"`
+	Skipped      bool            `json:"skipped,omitempty"`
+	SkipReason   string          `json:"skipReason,omitempty"`
+	Error        string          `json:"error,omitempty"`
+}
+
+type ResourceDrift struct {
+	ResourceType ResourceType `json:"resourceType"`
+	ResourceName string       `json:"resourceName"`
+	FieldDiffs   []FieldDiff  `json:"fieldDiffs,omitempty"`
+}
+
+func NewDriftReport() *DriftReport {
+
+	return &DriftReport{
+		GeneratedAt:  time.Now(),
+		ServerUrl:    SERVER_CONFIGS.ServerUrl,
+		TenantDomain: SERVER_CONFIGS.TenantDomain,
+		Organization: SERVER_CONFIGS.Organization,
+	}
+}
+
+// Compares the files exported from the deployed environment with the local files of a resource type, after replacing
+// the keywords in the local files. Masked secrets are not compared and the excluded resources are ignored.
+func DetectResourceDrift(resourceType ResourceType, localDirPath, deployedDirPath string) (ResourceTypeDrift, error) {
+
+	drift := ResourceTypeDrift{ResourceType: resourceType}
+	changes, err := compareResourceDirs(resourceType, localDirPath, deployedDirPath, true)
+	if err != nil {
+		return drift, err
+	}
+	for _, change := range changes {
+		resourceDrift := ResourceDrift{
+			ResourceType: change.ResourceType,
+			ResourceName: change.ResourceName,
+			FieldDiffs:   change.FieldDiffs,
+		}
+		switch change.Action {
+		case PLAN_CREATE:
+			drift.Removed = append(drift.Removed, resourceDrift)
+		case PLAN_DELETE:
+			drift.Added = append(drift.Added, resourceDrift)
+		case PLAN_UPDATE:
+			drift.Changed = append(drift.Changed, resourceDrift)
+		}
+	}
+	return drift, nil
+}
+
+func (report *DriftReport) AddResourceTypeDrift(drift ResourceTypeDrift) {
+
+	report.ResourceTypes = append(report.ResourceTypes, drift)
+	if len(drift.Added) > 0 || len(drift.Removed) > 0 || len(drift.Changed) > 0 {
+		report.Drifted = true
+	}
+}
+
+func (report *DriftReport) HasErrors() bool {
+
+	for _, drift := range report.ResourceTypes {
+		if drift.Error != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (report *DriftReport) WriteToFile(filePath string) error {
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing the drift report: %w", err)
+	}
+	if err := ioutil.WriteFile(filePath, data, 0644); err != nil {
+		return fmt.Errorf("error writing the drift report: %w", err)
+	}
+	return nil
+}
+
+func PrintDriftReport(report *DriftReport) {
+
+	var added, removed, changed int
+	for _, drift := range report.ResourceTypes {
+		fmt.Println("========================================")
+		fmt.Println(drift.ResourceType)
+		fmt.Println("========================================")
+		if drift.Skipped {
+			fmt.Printf("Skipped - %s\n", drift.SkipReason)
+			continue
+		}
+		if drift.Error != "" {
+			fmt.Printf("Failed - %s\n", drift.Error)
+			continue
+		}
+		if len(drift.Added) == 0 && len(drift.Removed) == 0 && len(drift.Changed) == 0 {
+			fmt.Println("No drift detected.")
+			continue
+		}
+		for _, resource := range drift.Added {
+			fmt.Printf("  + added   %s\n", resource.ResourceName)
+		}
+		for _, resource := range drift.Removed {
+			fmt.Printf("  - removed %s\n", resource.ResourceName)
+		}
+		for _, resource := range drift.Changed {
+			fmt.Printf("  ~ changed %s\n", resource.ResourceName)
+			for _, diff := range resource.FieldDiffs {
+				fmt.Printf("      %s: %s -> %s\n", diff.Path, FormatDiffValue(diff.Local), FormatDiffValue(diff.Deployed))
+			}
+		}
+		added += len(drift.Added)
+		removed += len(drift.Removed)
+		changed += len(drift.Changed)
+	}
+
+	fmt.Println("========================================")
+	fmt.Println("Drift Summary:")
+	fmt.Println("========================================")
+	fmt.Printf("Added: %d\n", added)
+	fmt.Printf("Removed: %d\n", removed)
+	fmt.Printf("Changed: %d\n", changed)
+	fmt.Println("========================================")
+}
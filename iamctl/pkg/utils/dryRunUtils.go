@@ -0,0 +1,142 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Identifier returned for the resources that would have been created in a dry run.
+const DRY_RUN_RESOURCE_ID = "dry-run-resource-id"
+
+// When enabled, requests that modify the target environment are not sent and local files are not modified.
+var DryRun bool
+
+func PrintDryRun(msg string) {
+
+	log.Printf("DRY RUN: %s", msg)
+}
+
+// Writes an exported file. Unchanged files are not rewritten, so that their modification times are kept.
+func WriteExportedFile(filePath string, data []byte) error {
+
+	if existing, err := ioutil.ReadFile(filePath); err == nil && bytes.Equal(existing, data) {
+		return nil
+	}
+	if DryRun {
+		PrintDryRun(fmt.Sprintf("Would write the file: %s", filePath))
+		return nil
+	}
+	return ioutil.WriteFile(filePath, data, 0644)
+}
+
+func CreateExportDirectory(dirPath string) error {
+
+	if DryRun {
+		PrintDryRun(fmt.Sprintf("Would create the directory: %s", dirPath))
+		return nil
+	}
+	return os.MkdirAll(dirPath, 0700)
+}
+
+// Removes a file or an empty directory.
+func RemoveLocalPath(path string) error {
+
+	if DryRun {
+		PrintDryRun(fmt.Sprintf("Would remove: %s", path))
+		return nil
+	}
+	return os.Remove(path)
+}
+
+func RemoveLocalDirectory(dirPath string) error {
+
+	if DryRun {
+		PrintDryRun(fmt.Sprintf("Would remove the directory: %s", dirPath))
+		return nil
+	}
+	return os.RemoveAll(dirPath)
+}
+
+// Returns a successful response without sending the request, if the request modifies the target environment.
+// Requests for resources created in the dry run are answered as well, since they do not exist in the target environment.
+func getDryRunResponse(request *http.Request, requestBody []byte) (*http.Response, bool) {
+
+	if request.Method == http.MethodGet {
+		if !strings.Contains(request.URL.Path, DRY_RUN_RESOURCE_ID) {
+			return nil, false
+		}
+		// Sub resources of a resource created in the dry run are always empty.
+		responseBody := "[]"
+		if strings.HasSuffix(request.URL.Path, DRY_RUN_RESOURCE_ID) {
+			responseBody = "{}"
+		}
+		return newDryRunResponse(request, http.StatusOK, []byte(responseBody)), true
+	}
+
+	msg := fmt.Sprintf("Would send the request: %s %s", request.Method, request.URL.String())
+	if len(requestBody) > 0 && TOOL_CONFIGS.Logs.LogRequestPayloads {
+		msg = fmt.Sprintf("%s with body: %s", msg, string(requestBody))
+	}
+	PrintDryRun(msg)
+
+	switch request.Method {
+	case http.MethodPost:
+		response := newDryRunResponse(request, http.StatusCreated, buildDryRunCreatedBody(requestBody))
+		response.Header.Set("Location", strings.TrimSuffix(request.URL.String(), "/")+"/"+DRY_RUN_RESOURCE_ID)
+		return response, true
+	case http.MethodDelete:
+		return newDryRunResponse(request, http.StatusNoContent, nil), true
+	default:
+		return newDryRunResponse(request, http.StatusOK, []byte("{}")), true
+	}
+}
+
+// Echoes the request body with a placeholder identifier, as the servers return the created resource.
+func buildDryRunCreatedBody(requestBody []byte) []byte {
+
+	var created map[string]interface{}
+	if err := json.Unmarshal(requestBody, &created); err != nil || created == nil {
+		created = make(map[string]interface{})
+	}
+	created["id"] = DRY_RUN_RESOURCE_ID
+	body, err := json.Marshal(created)
+	if err != nil {
+		return []byte("{}")
+	}
+	return body
+}
+
+func newDryRunResponse(request *http.Request, statusCode int, body []byte) *http.Response {
+
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode: statusCode,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+		Request:    request,
+	}
+}
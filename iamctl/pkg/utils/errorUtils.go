@@ -20,6 +20,7 @@ package utils
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -41,6 +42,168 @@ type FailedOperation struct {
 	ClaimURI    string `json:"claimURI,omitempty"`
 }
 
+// Error of a request rejected by the server, with the error response of the server if the response has one.
+type ServerError struct {
+	StatusCode int
+	Message    string
+	Response   *ErrorResponse
+}
+
+func (e *ServerError) Error() string {
+
+	if e.Response == nil {
+		return e.Message
+	}
+	return fmt.Sprintf("%s %s", e.Message, e.Response.Details())
+}
+
+// Returns the code, description and trace ID of the error response, e.g. "[APP-60001] Invalid request. (trace ID: 1a2b)".
+func (e *ErrorResponse) Details() string {
+
+	description := strings.TrimSpace(e.Description)
+	if description == "" {
+		description = strings.TrimSpace(e.Message)
+	}
+	details := description
+	if e.Code != "" {
+		details = strings.TrimSpace(fmt.Sprintf("[%s] %s", e.Code, description))
+	}
+	if e.TraceID != "" {
+		details = strings.TrimSpace(fmt.Sprintf("%s (trace ID: %s)", details, e.TraceID))
+	}
+	return details
+}
+
+// Error in the configs of the tool, such as a missing or malformed config file. No resources can be processed
+// without valid configs.
+type ConfigError struct {
+	Message string
+	Err     error
+}
+
+func (e *ConfigError) Error() string {
+
+	if e.Err == nil {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Message, e.Err)
+}
+
+func (e *ConfigError) Unwrap() error {
+
+	return e.Err
+}
+
+// Error in getting an access token for the target environment.
+type AuthError struct {
+	Message string
+	Err     error
+}
+
+func (e *AuthError) Error() string {
+
+	if e.Err == nil {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Message, e.Err)
+}
+
+func (e *AuthError) Unwrap() error {
+
+	return e.Err
+}
+
+// Failures of an operation run with the Go client, such as the resources that could not be exported or imported.
+// The failures are also recorded in the summary.
+type OperationError struct {
+	Operation     string
+	ResourceTypes []ResourceTypeSummaryReport
+	Interrupted   bool
+}
+
+func (e *OperationError) Error() string {
+
+	var failures []string
+	for _, resourceType := range e.ResourceTypes {
+		if len(resourceType.FailedResources) == 0 {
+			failures = append(failures, fmt.Sprintf("%s failed", resourceType.ResourceType))
+		} else {
+			failures = append(failures, fmt.Sprintf("%s failed for %s", resourceType.ResourceType,
+				strings.Join(resourceType.FailedResources, ", ")))
+		}
+	}
+	if e.Interrupted {
+		failures = append(failures, "interrupted")
+	}
+	return fmt.Sprintf("%s failed: %s", e.Operation, strings.Join(failures, "; "))
+}
+
+// Returns the failures recorded in the summary between the given summary reports, or nil if there are none.
+func NewOperationError(before, after SummaryReport) error {
+
+	previous := make(map[ResourceType]ResourceTypeSummaryReport)
+	for _, resourceType := range before.ResourceTypes {
+		previous[resourceType.ResourceType] = resourceType
+	}
+
+	operationError := &OperationError{Operation: after.Operation, Interrupted: after.Interrupted && !before.Interrupted}
+	for _, resourceType := range after.ResourceTypes {
+		if resourceType.Status != RESOURCE_TYPE_STATUS_FAILED {
+			continue
+		}
+		recorded, exists := previous[resourceType.ResourceType]
+		failedCount := resourceType.FailedCount - recorded.FailedCount
+		if exists && recorded.Status == RESOURCE_TYPE_STATUS_FAILED && failedCount == 0 {
+			continue
+		}
+		resourceType.FailedCount = failedCount
+		if len(recorded.FailedResources) <= len(resourceType.FailedResources) {
+			resourceType.FailedResources = resourceType.FailedResources[len(recorded.FailedResources):]
+		}
+		if len(recorded.Failures) <= len(resourceType.Failures) {
+			resourceType.Failures = resourceType.Failures[len(recorded.Failures):]
+		}
+		operationError.ResourceTypes = append(operationError.ResourceTypes, resourceType)
+	}
+	if len(operationError.ResourceTypes) == 0 && !operationError.Interrupted {
+		return nil
+	}
+	return operationError
+}
+
+// Creates the error of a request rejected by the server with the given status code and response body.
+func NewServerError(statusCode int, responseBody []byte, message string) error {
+
+	return &ServerError{
+		StatusCode: statusCode,
+		Message:    message,
+		Response:   ParseErrorResponse(responseBody),
+	}
+}
+
+// Parses the error response of the server. Returns nil if the response body is not an error response.
+func ParseErrorResponse(responseBody []byte) *ErrorResponse {
+
+	var errorResponse ErrorResponse
+	if err := json.Unmarshal(responseBody, &errorResponse); err != nil {
+		return nil
+	}
+	if errorResponse.Code == "" && errorResponse.Description == "" && errorResponse.Message == "" {
+		return nil
+	}
+	return &errorResponse
+}
+
+// Returns the error response of the server the error was caused by, if any.
+func GetErrorResponse(err error) *ErrorResponse {
+
+	var serverError *ServerError
+	if errors.As(err, &serverError) {
+		return serverError.Response
+	}
+	return nil
+}
+
 func handleClaimImportErrorResponse(resp *http.Response) error {
 
 	responseBody, err := ioutil.ReadAll(resp.Body)
@@ -55,7 +218,12 @@ func handleClaimImportErrorResponse(resp *http.Response) error {
 	}
 
 	errorMessages := collectFailedOperations(errorResponse.FailedOperations)
-	return fmt.Errorf("error response for the import request: %s\n%s", errorResponse.Message, strings.Join(errorMessages, "\n"))
+	return &ServerError{
+		StatusCode: resp.StatusCode,
+		Message: fmt.Sprintf("error response for the import request: %s\n%s", errorResponse.Message,
+			strings.Join(errorMessages, "\n")),
+		Response: &errorResponse,
+	}
 }
 
 func collectFailedOperations(failedOperations []FailedOperation) []string {
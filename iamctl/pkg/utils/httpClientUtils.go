@@ -0,0 +1,228 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type HttpConfigs struct {
+	ConnectTimeoutSeconds int  `json:"CONNECT_TIMEOUT_SECONDS"`
+	ReadTimeoutSeconds    int  `json:"READ_TIMEOUT_SECONDS"`
+	RequestTimeoutSeconds int  `json:"REQUEST_TIMEOUT_SECONDS"`
+	MaxRetries            int  `json:"MAX_RETRIES"`
+	RetryBaseDelayMs      int  `json:"RETRY_BASE_DELAY_MS"`
+	RetryMaxDelayMs       int  `json:"RETRY_MAX_DELAY_MS"`
+	SendRequestId         bool `json:"SEND_REQUEST_ID"`
+}
+
+func DefaultHttpConfigs() HttpConfigs {
+
+	return HttpConfigs{
+		ConnectTimeoutSeconds: 10,
+		ReadTimeoutSeconds:    60,
+		RequestTimeoutSeconds: 300,
+		MaxRetries:            3,
+		RetryBaseDelayMs:      500,
+		RetryMaxDelayMs:       30000,
+	}
+}
+
+// Client shared by all the requests sent to the server, so that the connections are reused.
+var (
+	httpClient     *http.Client
+	httpConfigs    = DefaultHttpConfigs()
+	httpClientLock sync.Mutex
+)
+
+// Uses the given HTTP configs for all the requests sent to the server.
+func InitHTTPClient(configs HttpConfigs) {
+
+	httpClientLock.Lock()
+	defer httpClientLock.Unlock()
+
+	httpConfigs = configs
+	httpClient = nil
+}
+
+// Returns the shared HTTP client, created with the configured HTTP and TLS configs. The requests are recorded or
+// replayed through the client when a cassette is used.
+func GetHTTPClient() *http.Client {
+
+	httpClientLock.Lock()
+	defer httpClientLock.Unlock()
+
+	if httpClient == nil {
+		var transport http.RoundTripper = &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   time.Duration(httpConfigs.ConnectTimeoutSeconds) * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			TLSClientConfig:       tlsConfig,
+			TLSHandshakeTimeout:   time.Duration(httpConfigs.ConnectTimeoutSeconds) * time.Second,
+			ResponseHeaderTimeout: time.Duration(httpConfigs.ReadTimeoutSeconds) * time.Second,
+			MaxIdleConns:          100,
+			MaxIdleConnsPerHost:   32,
+			IdleConnTimeout:       90 * time.Second,
+		}
+		if mode := getCassetteMode(); mode != "" {
+			transport = &cassetteTransport{mode: mode, next: transport}
+		}
+		httpClient = &http.Client{
+			Timeout:   time.Duration(httpConfigs.RequestTimeoutSeconds) * time.Second,
+			Transport: transport,
+		}
+	}
+	return httpClient
+}
+
+func resetHTTPClient() {
+
+	httpClientLock.Lock()
+	defer httpClientLock.Unlock()
+
+	httpClient = nil
+}
+
+// Sends a request with the shared HTTP client. Requests rejected with 429 or 503 are retried, and idempotent
+// requests are retried on connection errors and 502 or 504 responses as well, with an exponential backoff.
+// The retries of a request are sent with the same correlation and request IDs.
+func doRequest(request *http.Request) (*http.Response, error) {
+
+	client := GetHTTPClient()
+	httpClientLock.Lock()
+	configs := httpConfigs
+	httpClientLock.Unlock()
+
+	var requestId string
+	if configs.SendRequestId {
+		requestId = newTraceId()
+	}
+	for attempt := 0; ; attempt++ {
+		resp, err := sendTracedRequest(client, request, requestId, attempt)
+		if attempt >= configs.MaxRetries || !isRetryable(request, resp, err) {
+			return resp, err
+		}
+
+		delay := getRetryDelay(configs, attempt, resp)
+		if err != nil {
+			PrintLog(LogLevelInfo, UtilsResourceWrapper, "", fmt.Sprintf("Retrying %s %s in %s after error: %s",
+				request.Method, request.URL.Path, delay, err))
+		} else {
+			PrintLog(LogLevelInfo, UtilsResourceWrapper, "", fmt.Sprintf("Retrying %s %s in %s after response: %d",
+				request.Method, request.URL.Path, delay, resp.StatusCode))
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		select {
+		case <-time.After(delay):
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		}
+
+		if request.Body != nil {
+			body, err := request.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("error resetting the request body for retrying: %w", err)
+			}
+			request.Body = body
+		}
+	}
+}
+
+func isRetryable(request *http.Request, resp *http.Response, err error) bool {
+
+	// The body of the request cannot be sent again.
+	if request.Body != nil && request.GetBody == nil {
+		return false
+	}
+	if err != nil {
+		return isIdempotent(request.Method) && !errors.Is(err, ErrNoRecordedResponse)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotent(request.Method)
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// Returns the delay given in the Retry-After header of the response if any, or an exponential backoff
+// with jitter otherwise. The delay does not exceed the maximum retry delay.
+func getRetryDelay(configs HttpConfigs, attempt int, resp *http.Response) time.Duration {
+
+	maxDelay := time.Duration(configs.RetryMaxDelayMs) * time.Millisecond
+	if resp != nil {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if delay > maxDelay {
+				return maxDelay
+			}
+			return delay
+		}
+	}
+
+	delay := time.Duration(configs.RetryBaseDelayMs) * time.Millisecond << uint(attempt)
+	if delay > maxDelay || delay < 0 {
+		delay = maxDelay
+	}
+	// Jitter between half and the full delay, so that concurrent requests are not retried at once.
+	if delay > 1 {
+		delay = delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+	}
+	return delay
+}
+
+// Parses the Retry-After header, given either in seconds or as a HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
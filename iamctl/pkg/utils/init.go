@@ -22,7 +22,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"os"
 )
 
@@ -73,43 +72,38 @@ type MyJSON struct {
 	Array []ServerDetails
 }
 
-func CreateFile() {
+// Creates the file the access tokens of the interactive mode are stored in, if it does not exist.
+func CreateFile() error {
 
 	// detect if file exists
 	var _, err = os.Stat(Path)
 	// create file if not exists
 	if os.IsNotExist(err) {
-		var file, err = os.Create(Path)
-		checkError(err)
-		defer file.Close()
-
 		jsonData := &MyJSON{Array: []ServerDetails{}}
-		encodeJson, _ := json.Marshal(jsonData)
-
+		encodeJson, err := json.Marshal(jsonData)
 		if err != nil {
-			log.Fatalln(err)
+			return err
 		}
-		err = ioutil.WriteFile(Path, encodeJson, 0644)
-		if err != nil {
-			log.Fatalln(err)
+		if err = ioutil.WriteFile(Path, encodeJson, 0644); err != nil {
+			return &ConfigError{Message: "error creating the access token file", Err: err}
 		}
 	}
+	return nil
 }
 
-func WriteFiles(server string, token string, refreshToken string) {
+func WriteFiles(server string, token string, refreshToken string) error {
 
-	var err error
 	var data MyJSON
 	var msg = new(ServerDetails)
 
 	file, err := ioutil.ReadFile(Path)
 	if err != nil {
-		log.Fatalln(err)
+		return &ConfigError{Message: "error reading the access token file", Err: err}
 	}
 
 	err = json.Unmarshal(file, &data)
 	if err != nil {
-		log.Fatalln(err)
+		return &ConfigError{Message: "access token file is not in the correct format", Err: err}
 	}
 
 	msg.AccessToken = token
@@ -131,76 +125,64 @@ func WriteFiles(server string, token string, refreshToken string) {
 
 	jsonData, err := json.Marshal(data)
 	if err != nil {
-		log.Fatalln(err)
+		return err
 	}
-	err = ioutil.WriteFile(Path, jsonData, 0644)
-	if err != nil {
-		log.Fatalln(err)
-	} else {
-		fmt.Println("Authorization is done for : " + server)
+	if err = ioutil.WriteFile(Path, jsonData, 0644); err != nil {
+		return &ConfigError{Message: "error writing the access token file", Err: err}
 	}
-	checkError(err)
+	fmt.Println("Authorization is done for : " + server)
+	return nil
 }
 
-func ReadFile() string {
+func ReadFile() (string, error) {
 
 	var a ServerDetails
 	var data MyJSON
 
 	file, err := ioutil.ReadFile(Path)
 	if err != nil {
-		log.Fatalln(err)
+		return "", &ConfigError{Message: "error reading the access token file", Err: err}
 	}
 
 	err = json.Unmarshal(file, &data)
 	if err != nil {
-		log.Fatalln(err)
+		return "", &ConfigError{Message: "access token file is not in the correct format", Err: err}
 	}
 	//as the single host this worked. For multiple host need to read relevant accessToken according to given server
 	for i := 0; i < len(data.Array); i++ {
 		a = data.Array[i]
 	}
-	return a.AccessToken
+	return a.AccessToken, nil
 }
 
-func checkError(err error) {
-
-	if err != nil {
-		fmt.Println(err.Error())
-		os.Exit(0)
-	}
-}
-
-func CreateSampleSPFile() {
+// Creates the file the server details of the interactive mode are stored in, if it does not exist.
+func CreateSampleSPFile() error {
 
 	// detect if file exists
 	var _, err = os.Stat(PathSampleSPDetails)
 	// create file if not exists
 	if os.IsNotExist(err) {
-		var file, err = os.Create(PathSampleSPDetails)
-		checkError(err)
-		defer file.Close()
 		jsonData := &SampleSP{}
-		encodeJson, _ := json.Marshal(jsonData)
+		encodeJson, err := json.Marshal(jsonData)
 		if err != nil {
-			log.Fatalln(err)
+			return err
 		}
-		err = ioutil.WriteFile(PathSampleSPDetails, encodeJson, 0644)
-		if err != nil {
-			log.Fatalln(err)
+		if err = ioutil.WriteFile(PathSampleSPDetails, encodeJson, 0644); err != nil {
+			return &ConfigError{Message: "error creating the server details file", Err: err}
 		}
 	}
+	return nil
 }
 
-func ReadSPConfig() (string, string, string, string) {
+func ReadSPConfig() (string, string, string, string, error) {
 
 	var data SampleSP
 
 	file, _ := ioutil.ReadFile(PathSampleSPDetails)
 	err := json.Unmarshal(file, &data)
 	if err != nil {
-		log.Fatalln(err)
+		return "", "", "", "", &ConfigError{Message: "server details file is not in the correct format", Err: err}
 	}
 
-	return data.Server, data.ClientID, data.ClientSecret, data.Tenant
+	return data.Server, data.ClientID, data.ClientSecret, data.Tenant, nil
 }
@@ -0,0 +1,125 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// The run context is cancelled on the first interrupt, so that no new resources are processed. The requests of the
+// resources being processed are sent with the request context, which is only cancelled on the second interrupt,
+// so that the resources are not left partially configured.
+var (
+	runContext     context.Context
+	cancelRun      context.CancelFunc
+	requestContext context.Context
+	cancelRequests context.CancelFunc
+	interruptLock  sync.Mutex
+)
+
+func init() {
+
+	ResetInterrupt()
+}
+
+// Handles SIGINT and SIGTERM for the rest of the run. The first signal stops the run after the resources being
+// processed, and the second one cancels the requests in flight. The returned channel is closed on the third signal,
+// after which the signals are no longer handled, so that the caller can exit immediately.
+func HandleInterrupts() <-chan struct{} {
+
+	signals := make(chan os.Signal, 3)
+	forceExit := make(chan struct{})
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		for count := 1; ; count++ {
+			<-signals
+			switch count {
+			case 1:
+				PrintLog(LogLevelWarn, UtilsResourceWrapper, "", "Interrupt received. Finishing the resources being "+
+					"processed. Interrupt again to cancel the requests in flight.")
+				Interrupt()
+			case 2:
+				PrintLog(LogLevelWarn, UtilsResourceWrapper, "", "Cancelling the requests in flight. Interrupt again "+
+					"to exit immediately.")
+				CancelRequests()
+			default:
+				signal.Stop(signals)
+				close(forceExit)
+				return
+			}
+		}
+	}()
+	return forceExit
+}
+
+// Stops processing new resources.
+func Interrupt() {
+
+	interruptLock.Lock()
+	defer interruptLock.Unlock()
+
+	cancelRun()
+}
+
+// Stops processing new resources and cancels the requests in flight.
+func CancelRequests() {
+
+	interruptLock.Lock()
+	defer interruptLock.Unlock()
+
+	cancelRun()
+	cancelRequests()
+}
+
+// Starts a new run that is not interrupted.
+func ResetInterrupt() {
+
+	interruptLock.Lock()
+	defer interruptLock.Unlock()
+
+	runContext, cancelRun = context.WithCancel(context.Background())
+	requestContext, cancelRequests = context.WithCancel(context.Background())
+}
+
+func IsInterrupted() bool {
+
+	return RunContext().Err() != nil
+}
+
+// Returns the context of the run, which is done once the run is interrupted.
+func RunContext() context.Context {
+
+	interruptLock.Lock()
+	defer interruptLock.Unlock()
+
+	return runContext
+}
+
+// Returns the context the requests are sent with, unless a context is given to the request.
+func RequestContext() context.Context {
+
+	interruptLock.Lock()
+	defer interruptLock.Unlock()
+
+	return requestContext
+}
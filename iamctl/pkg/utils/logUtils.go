@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -40,12 +41,23 @@ type ResourceTypeSummary struct {
 	DeletedCount                int
 	SecretGeneratedApplications []string
 	FailedResources             []string
+	Failures                    []ResourceFailure
 	Skipped                     bool
 	SkipReason                  string
 	Failed                      bool
+	Interrupted                 bool
 	Duration                    time.Duration
 }
 
+// Failure of a resource, with the details of the error response if the server rejected the request.
+type ResourceFailure struct {
+	ResourceName string `json:"resourceName"`
+	Error        string `json:"error,omitempty"`
+	Code         string `json:"code,omitempty"`
+	Description  string `json:"description,omitempty"`
+	TraceID      string `json:"traceId,omitempty"`
+}
+
 var (
 	AggregatedSummary Summary
 	ResTypeSummaryMap map[ResourceType]ResourceTypeSummary
@@ -54,6 +66,9 @@ var (
 	StartTime         time.Time
 )
 
+// Guards the summary state, as resources may be processed concurrently.
+var summaryLock sync.Mutex
+
 var CURRENT_LOG_LEVEL LogLevel = LogLevelInfo
 
 func resolveLogLevel(levelStr string) LogLevel {
@@ -86,11 +101,17 @@ func levelPrefix(level LogLevel) string {
 
 func MarkResTypeStart(resourceType ResourceType) {
 
+	summaryLock.Lock()
+	defer summaryLock.Unlock()
+
 	ResTypeStartTimes[resourceType] = time.Now()
 }
 
 func MarkResTypeEnd(resourceType ResourceType) {
 
+	summaryLock.Lock()
+	defer summaryLock.Unlock()
+
 	startTime, ok := ResTypeStartTimes[resourceType]
 	if !ok {
 		return
@@ -103,6 +124,9 @@ func MarkResTypeEnd(resourceType ResourceType) {
 
 func UpdateSkipSummary(resourceType ResourceType, reason string) {
 
+	summaryLock.Lock()
+	defer summaryLock.Unlock()
+
 	InitializeResTypeSummaryMap()
 	summary := getOrInitSummary(resourceType)
 	summary.Skipped = true
@@ -112,14 +136,32 @@ func UpdateSkipSummary(resourceType ResourceType, reason string) {
 
 func MarkResTypeFailure(resourceType ResourceType) {
 
+	summaryLock.Lock()
+	defer summaryLock.Unlock()
+
 	InitializeResTypeSummaryMap()
 	summary := getOrInitSummary(resourceType)
 	summary.Failed = true
 	ResTypeSummaryMap[resourceType] = summary
 }
 
+// Marks the resource type as being processed when the run was interrupted, so that some of its resources may not be processed.
+func MarkResTypeInterrupted(resourceType ResourceType) {
+
+	summaryLock.Lock()
+	defer summaryLock.Unlock()
+
+	InitializeResTypeSummaryMap()
+	summary := getOrInitSummary(resourceType)
+	summary.Interrupted = true
+	ResTypeSummaryMap[resourceType] = summary
+}
+
 func AddNewSecretIndicatorToSummary(appName string) {
 
+	summaryLock.Lock()
+	defer summaryLock.Unlock()
+
 	InitializeResTypeSummaryMap()
 	summary := getOrInitSummary(APPLICATIONS)
 	summary.SecretGeneratedApplications = append(summary.SecretGeneratedApplications, appName)
@@ -128,6 +170,9 @@ func AddNewSecretIndicatorToSummary(appName string) {
 
 func UpdateSuccessSummary(resourceType ResourceType, operation string) {
 
+	summaryLock.Lock()
+	defer summaryLock.Unlock()
+
 	InitializeResTypeSummaryMap()
 
 	AggregatedSummary.TotalRequests++
@@ -147,7 +192,10 @@ func UpdateSuccessSummary(resourceType ResourceType, operation string) {
 	ResTypeSummaryMap[resourceType] = summary
 }
 
-func UpdateFailureSummary(resourceType ResourceType, resourceName string) {
+func UpdateFailureSummary(resourceType ResourceType, resourceName string, err error) {
+
+	summaryLock.Lock()
+	defer summaryLock.Unlock()
 
 	InitializeResTypeSummaryMap()
 
@@ -157,9 +205,25 @@ func UpdateFailureSummary(resourceType ResourceType, resourceName string) {
 	summary := getOrInitSummary(resourceType)
 	summary.FailedCount++
 	summary.FailedResources = append(summary.FailedResources, resourceName)
+	summary.Failures = append(summary.Failures, newResourceFailure(resourceName, err))
 	ResTypeSummaryMap[resourceType] = summary
 }
 
+func newResourceFailure(resourceName string, err error) ResourceFailure {
+
+	failure := ResourceFailure{ResourceName: resourceName}
+	if err == nil {
+		return failure
+	}
+	failure.Error = err.Error()
+	if errorResponse := GetErrorResponse(err); errorResponse != nil {
+		failure.Code = errorResponse.Code
+		failure.Description = errorResponse.Description
+		failure.TraceID = errorResponse.TraceID
+	}
+	return failure
+}
+
 func PrintLog(level LogLevel, packageName ResourceType, resourceName string, msg string) {
 
 	var body string
@@ -170,7 +234,9 @@ func PrintLog(level LogLevel, packageName ResourceType, resourceName string, msg
 	}
 
 	if level == LogLevelWarn {
+		summaryLock.Lock()
 		Warnings = append(Warnings, body)
+		summaryLock.Unlock()
 	}
 	if level < CURRENT_LOG_LEVEL {
 		return
@@ -189,6 +255,7 @@ func PrintSummary(Operation string) {
 	var successCount int
 	var skippedTypes []skippedEntry
 	var failedTypes []string
+	var interruptedTypes []string
 
 	for rt, summary := range ResTypeSummaryMap {
 		if summary.Skipped {
@@ -198,11 +265,21 @@ func PrintSummary(Operation string) {
 		} else {
 			successCount++
 		}
+		if summary.Interrupted {
+			interruptedTypes = append(interruptedTypes, rt.String())
+		}
 	}
 
 	fmt.Println("========================================")
 	fmt.Println("Total Summary:")
 	fmt.Println("========================================")
+	if DryRun {
+		fmt.Println("Dry run: No changes were made to the target environment or the local files.")
+	}
+	if IsInterrupted() {
+		fmt.Println("Interrupted: The run was stopped before all the resources were processed.")
+	}
+	fmt.Printf("Correlation ID: %s\n", GetCorrelationId())
 	fmt.Printf("Total Operations: %d\n", AggregatedSummary.TotalRequests)
 	fmt.Printf("Successful Operations: %d\n", AggregatedSummary.SuccessfulOperations)
 	fmt.Printf("Failed Operations: %d\n", AggregatedSummary.FailedOperations)
@@ -229,6 +306,15 @@ func PrintSummary(Operation string) {
 		}
 	}
 
+	if len(interruptedTypes) > 0 {
+		fmt.Println("========================================")
+		fmt.Println("Interrupted Resource Types")
+		fmt.Println("========================================")
+		for _, i := range interruptedTypes {
+			fmt.Printf("%s - Some resources may not be processed\n", i)
+		}
+	}
+
 	fmt.Println("========================================")
 	fmt.Println("Per Resource Breakdown")
 	fmt.Println("========================================")
@@ -314,6 +400,11 @@ func PrintFailedResources(summary ResourceTypeSummary) {
 		}
 	}
 	fmt.Println()
+	for _, failure := range summary.Failures {
+		if failure.Code != "" || failure.TraceID != "" {
+			fmt.Printf("%s: [%s] %s (trace ID: %s)\n", failure.ResourceName, failure.Code, failure.Description, failure.TraceID)
+		}
+	}
 }
 
 func printNewSecretApplications(summary ResourceTypeSummary) {
@@ -332,6 +423,67 @@ func printNewSecretApplications(summary ResourceTypeSummary) {
 	}
 }
 
+func HasFailures() bool {
+
+	summaryLock.Lock()
+	defer summaryLock.Unlock()
+
+	if AggregatedSummary.FailedOperations > 0 {
+		return true
+	}
+	for _, summary := range ResTypeSummaryMap {
+		if summary.Failed || summary.FailedCount > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolves the exit code of a command from the summary. An interrupted run takes precedence over failures, and a
+// failure of a whole resource type takes precedence over failures of individual resources.
+func GetExitCode() int {
+
+	if IsInterrupted() {
+		return EXIT_CODE_INTERRUPTED
+	}
+
+	summaryLock.Lock()
+	defer summaryLock.Unlock()
+
+	exitCode := EXIT_CODE_SUCCESS
+	for _, summary := range ResTypeSummaryMap {
+		if summary.Failed {
+			return EXIT_CODE_RESOURCE_TYPE_FAILURE
+		}
+		if summary.FailedCount > 0 {
+			exitCode = EXIT_CODE_PARTIAL_FAILURE
+		}
+	}
+	return exitCode
+}
+
+// Clears the summary, so that the operations recorded before a command starts modifying resources are not reported.
+func ResetSummary() {
+
+	summaryLock.Lock()
+	defer summaryLock.Unlock()
+
+	AggregatedSummary = Summary{}
+	ResTypeSummaryMap = nil
+	Warnings = nil
+	ResTypeStartTimes = make(map[ResourceType]time.Time)
+}
+
+// Returns the summary of a resource type, if any operations are recorded for it.
+func GetResTypeSummary(resourceType ResourceType) (ResourceTypeSummary, bool) {
+
+	summaryLock.Lock()
+	defer summaryLock.Unlock()
+
+	summary, exists := ResTypeSummaryMap[resourceType]
+	return summary, exists
+}
+
 func InitializeResTypeSummaryMap() {
 
 	if ResTypeSummaryMap == nil {
@@ -0,0 +1,324 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	MANIFEST_DIR_NAME  = ".iamctl"
+	MANIFEST_FILE_NAME = "manifest.yaml"
+	MANIFEST_VERSION   = 1
+	HASH_PREFIX_SHA256 = "sha256:"
+)
+
+// Lists the files of the resources exported from an environment, along with their server side identifiers
+// and content hashes.
+type ExportManifest struct {
+	Version       int                `yaml:"version"`
+	ServerUrl     string             `yaml:"serverUrl"`
+	TenantDomain  string             `yaml:"tenantDomain"`
+	Organization  string             `yaml:"organization,omitempty"`
+	ServerVersion string             `yaml:"serverVersion,omitempty"`
+	ExportedAt    time.Time          `yaml:"exportedAt"`
+	Resources     []ManifestResource `yaml:"resources"`
+}
+
+// A file of an exported resource. The path of the file is relative to the exported directory.
+type ManifestResource struct {
+	ResourceType ResourceType `yaml:"resourceType"`
+	Name         string       `yaml:"name"`
+	Id           string       `yaml:"id,omitempty"`
+	File         string       `yaml:"file"`
+	Hash         string       `yaml:"hash"`
+}
+
+// A local resource whose name is changed since it was exported.
+type ResourceRename struct {
+	ResourceType ResourceType
+	OldName      string
+	NewName      string
+	Id           string
+}
+
+func GetManifestPath(dirPath string) string {
+
+	return filepath.Join(dirPath, MANIFEST_DIR_NAME, MANIFEST_FILE_NAME)
+}
+
+// Loads the manifest of the given exported directory. Returns nil if the directory has no manifest.
+func LoadManifest(dirPath string) (*ExportManifest, error) {
+
+	data, err := ioutil.ReadFile(GetManifestPath(dirPath))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading the manifest: %w", err)
+	}
+	var manifest ExportManifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("error parsing the manifest: %w", err)
+	}
+	if manifest.Version != MANIFEST_VERSION {
+		return nil, fmt.Errorf("unsupported manifest version: %d", manifest.Version)
+	}
+	return &manifest, nil
+}
+
+// Writes the manifest of the resource files in the exported directory. The identifiers registered while exporting
+// are recorded, and the identifiers of the resources not exported in this run are kept from the previous manifest
+// of the same environment.
+func WriteManifest(dirPath string) error {
+
+	if DryRun {
+		PrintDryRun(fmt.Sprintf("Would write the manifest: %s", GetManifestPath(dirPath)))
+		return nil
+	}
+
+	previousIds := make(map[ResourceKey]string)
+	previous, err := LoadManifest(dirPath)
+	if err != nil {
+		PrintLog(LogLevelWarn, UtilsResourceWrapper, "", "Ignoring the previous manifest: "+err.Error())
+	} else if previous != nil && isManifestOfCurrentEnvironment(previous) {
+		for _, resource := range previous.Resources {
+			previousIds[ResourceKey{resource.ResourceType, resource.Name}] = resource.Id
+		}
+	}
+
+	manifest := ExportManifest{
+		Version:       MANIFEST_VERSION,
+		ServerUrl:     SERVER_CONFIGS.ServerUrl,
+		TenantDomain:  SERVER_CONFIGS.TenantDomain,
+		Organization:  SERVER_CONFIGS.Organization,
+		ServerVersion: SERVER_CONFIGS.ServerVersion,
+		ExportedAt:    time.Now().UTC(),
+		Resources:     []ManifestResource{},
+	}
+	for _, resourceType := range ResourceOrder {
+		resources, err := getManifestResources(dirPath, resourceType, previousIds)
+		if err != nil {
+			return err
+		}
+		manifest.Resources = append(manifest.Resources, resources...)
+	}
+
+	data, err := yaml.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("error serializing the manifest: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(GetManifestPath(dirPath)), 0700); err != nil {
+		return fmt.Errorf("error creating the manifest directory: %w", err)
+	}
+	if err := ioutil.WriteFile(GetManifestPath(dirPath), data, 0644); err != nil {
+		return fmt.Errorf("error writing the manifest: %w", err)
+	}
+	return nil
+}
+
+func getManifestResources(dirPath string, resourceType ResourceType, previousIds map[ResourceKey]string) ([]ManifestResource, error) {
+
+	files, err := ListResourceFiles(filepath.Join(dirPath, resourceType.String()))
+	if err != nil {
+		return nil, fmt.Errorf("error reading the exported %s: %w", resourceType, err)
+	}
+	relativePaths := make([]string, 0, len(files))
+	for relativePath := range files {
+		relativePaths = append(relativePaths, relativePath)
+	}
+	sort.Strings(relativePaths)
+
+	// The identifiers are registered as a map of the identifiers to the names when exporting.
+	ids := make(map[string]string)
+	for id, name := range GetResourceIdentifierMap(resourceType) {
+		ids[name] = id
+	}
+
+	var resources []ManifestResource
+	for _, relativePath := range relativePaths {
+		filePath := files[relativePath]
+		configType, resourceName := ResolveResourceFromPath(resourceType, relativePath)
+		hash, err := GetResourceFileHash(filePath, configType, resourceName)
+		if err != nil {
+			return nil, err
+		}
+		id, exists := ids[resourceName]
+		if !exists || configType != resourceType {
+			id = previousIds[ResourceKey{configType, resourceName}]
+		}
+		file, err := filepath.Rel(dirPath, filePath)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, ManifestResource{
+			ResourceType: configType,
+			Name:         resourceName,
+			Id:           id,
+			File:         filepath.ToSlash(file),
+			Hash:         hash,
+		})
+	}
+	return resources, nil
+}
+
+// Returns the hash of the content of a resource file. The keywords are replaced with their values in the
+// current environment and the content is normalized, so that the hash of a local file matches the hash of the
+// deployed resource it is exported from.
+func GetResourceFileHash(filePath string, resourceType ResourceType, resourceName string) (string, error) {
+
+	content, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("error reading %s: %w", filePath, err)
+	}
+	return getContentHash(content, filepath.Ext(filePath), resourceType, resourceName), nil
+}
+
+func getContentHash(content []byte, fileExtension string, resourceType ResourceType, resourceName string) string {
+
+	content = []byte(ReplaceKeywords(string(content), GetResourceKeywordMapping(resourceType, resourceName)))
+	if format, err := FormatFromExtension(fileExtension); err == nil {
+		if format == FormatYAML {
+			content = ReplaceTypeTags(content)
+		}
+		// Files that cannot be parsed are hashed as they are.
+		if data, err := Deserialize(content, format, resourceType); err == nil {
+			if normalized, err := json.Marshal(ConvertToStringKeyMap(data)); err == nil {
+				content = normalized
+			}
+		}
+	}
+	hash := sha256.Sum256(content)
+	return HASH_PREFIX_SHA256 + hex.EncodeToString(hash[:])
+}
+
+// Detects the local resources renamed since they were exported, by matching the files removed since the export
+// with the new files that are identical apart from the name of the resource.
+func DetectRenamedResources(dirPath string) ([]ResourceRename, error) {
+
+	manifest, err := LoadManifest(dirPath)
+	if err != nil || manifest == nil {
+		return nil, err
+	}
+
+	exportedFiles := make(map[string]bool)
+	for _, resource := range manifest.Resources {
+		exportedFiles[resource.File] = true
+	}
+
+	var renames []ResourceRename
+	for _, resourceType := range ResourceOrder {
+		files, err := ListResourceFiles(filepath.Join(dirPath, resourceType.String()))
+		if err != nil {
+			return nil, fmt.Errorf("error reading the local %s: %w", resourceType, err)
+		}
+		for _, resource := range manifest.Resources {
+			if !strings.HasPrefix(resource.File, resourceType.String()+"/") {
+				continue
+			}
+			if _, err := os.Stat(filepath.Join(dirPath, filepath.FromSlash(resource.File))); err == nil {
+				continue
+			}
+			if rename, found := findRename(dirPath, resourceType, resource, files, exportedFiles); found {
+				renames = append(renames, rename)
+			}
+		}
+	}
+	return renames, nil
+}
+
+// Finds the new file of a resource whose file is removed since the export.
+func findRename(dirPath string, resourceType ResourceType, exported ManifestResource, files map[string]string,
+	exportedFiles map[string]bool) (ResourceRename, bool) {
+
+	relativePaths := make([]string, 0, len(files))
+	for relativePath := range files {
+		relativePaths = append(relativePaths, relativePath)
+	}
+	sort.Strings(relativePaths)
+
+	for _, relativePath := range relativePaths {
+		filePath := files[relativePath]
+		file, err := filepath.Rel(dirPath, filePath)
+		if err != nil || exportedFiles[filepath.ToSlash(file)] {
+			continue
+		}
+		configType, newName := ResolveResourceFromPath(resourceType, relativePath)
+		if configType != exported.ResourceType || newName == exported.Name ||
+			strings.Replace(filepath.ToSlash(file), newName, exported.Name, -1) != exported.File {
+			continue
+		}
+		content, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			continue
+		}
+		content = []byte(strings.Replace(string(content), newName, exported.Name, -1))
+		if getContentHash(content, filepath.Ext(filePath), exported.ResourceType, exported.Name) == exported.Hash {
+			return ResourceRename{
+				ResourceType: exported.ResourceType,
+				OldName:      exported.Name,
+				NewName:      newName,
+				Id:           exported.Id,
+			}, true
+		}
+	}
+	return ResourceRename{}, false
+}
+
+// Returns the exported resources whose deployed files in the given directory no longer match the manifest, since
+// they were modified in the server after the export. Only applicable if the manifest is of the current environment.
+func DetectServerModifications(manifest *ExportManifest, deployedDirPath string) ([]ManifestResource, error) {
+
+	if manifest == nil || !isManifestOfCurrentEnvironment(manifest) {
+		return nil, nil
+	}
+
+	var modified []ManifestResource
+	for _, resource := range manifest.Resources {
+		deployedFilePath := filepath.Join(deployedDirPath, filepath.FromSlash(resource.File))
+		if _, err := os.Stat(deployedFilePath); err != nil {
+			continue
+		}
+		hash, err := GetResourceFileHash(deployedFilePath, resource.ResourceType, resource.Name)
+		if err != nil {
+			return nil, err
+		}
+		if hash != resource.Hash {
+			modified = append(modified, resource)
+		}
+	}
+	return modified, nil
+}
+
+func isManifestOfCurrentEnvironment(manifest *ExportManifest) bool {
+
+	return manifest.ServerUrl == SERVER_CONFIGS.ServerUrl && manifest.TenantDomain == SERVER_CONFIGS.TenantDomain &&
+		manifest.Organization == SERVER_CONFIGS.Organization
+}
@@ -0,0 +1,189 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type PlanAction string
+
+const (
+	PLAN_CREATE PlanAction = "create"
+	PLAN_UPDATE PlanAction = "update"
+	PLAN_DELETE PlanAction = "delete"
+	PLAN_NO_OP  PlanAction = "no-op"
+)
+
+type ResourceChange struct {
+	ResourceType ResourceType `json:"resourceType"`
+	ResourceName string       `json:"resourceName"`
+	Action       PlanAction   `json:"action"`
+	FieldDiffs   []FieldDiff  `json:"fieldDiffs,omitempty"`
+}
+
+type ResourceTypePlan struct {
+	ResourceType ResourceType     `json:"resourceType"`
+	Changes      []ResourceChange `json:"changes"`
+	Skipped      bool             `json:"skipped,omitempty"`
+	SkipReason   string           `json:"skipReason,omitempty"`
+	Error        string           `json:"error,omitempty"`
+}
+
+// Compares the local files of a resource type with the files exported from the deployed environment
+// and returns the changes an import would make.
+func CompareResourceDirs(resourceType ResourceType, localDirPath, deployedDirPath string) ([]ResourceChange, error) {
+
+	return compareResourceDirs(resourceType, localDirPath, deployedDirPath, TOOL_CONFIGS.AllowDelete)
+}
+
+// Compares the local files of a resource type with the deployed files. The deployed resources missing locally
+// are returned as deletions only if includeDeleted is true.
+func compareResourceDirs(resourceType ResourceType, localDirPath, deployedDirPath string, includeDeleted bool) ([]ResourceChange, error) {
+
+	localFiles, err := ListResourceFiles(localDirPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading local files: %w", err)
+	}
+	deployedFiles, err := ListResourceFiles(deployedDirPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading deployed files: %w", err)
+	}
+
+	var changes []ResourceChange
+	for relativePath, localFilePath := range localFiles {
+		configType, resourceName := ResolveResourceFromPath(resourceType, relativePath)
+		if IsResourceExcluded(resourceName, GetResourceToolConfigs(configType)) {
+			continue
+		}
+		change := ResourceChange{
+			ResourceType: configType,
+			ResourceName: relativePath,
+		}
+		deployedFilePath, deployed := deployedFiles[relativePath]
+		if !deployed {
+			change.Action = PLAN_CREATE
+			changes = append(changes, change)
+			continue
+		}
+
+		dataType := resolveFileDataType(configType, relativePath)
+		localData, err := LoadResourceFile(localFilePath, GetResourceKeywordMapping(configType, resourceName), dataType)
+		if err != nil {
+			return nil, fmt.Errorf("error loading local file %s: %w", localFilePath, err)
+		}
+		deployedData, err := LoadResourceFile(deployedFilePath, nil, dataType)
+		if err != nil {
+			return nil, fmt.Errorf("error loading deployed file %s: %w", deployedFilePath, err)
+		}
+
+		change.FieldDiffs = CompareResourceData(localData, deployedData, dataType)
+		if len(change.FieldDiffs) > 0 {
+			change.Action = PLAN_UPDATE
+		} else {
+			change.Action = PLAN_NO_OP
+		}
+		changes = append(changes, change)
+	}
+
+	if includeDeleted {
+		for relativePath := range deployedFiles {
+			if _, exists := localFiles[relativePath]; exists {
+				continue
+			}
+			configType, resourceName := ResolveResourceFromPath(resourceType, relativePath)
+			if IsResourceExcluded(resourceName, GetResourceToolConfigs(configType)) {
+				continue
+			}
+			changes = append(changes, ResourceChange{
+				ResourceType: configType,
+				ResourceName: relativePath,
+				Action:       PLAN_DELETE,
+			})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].ResourceName < changes[j].ResourceName
+	})
+	return changes, nil
+}
+
+func resolveFileDataType(resourceType ResourceType, relativePath string) ResourceType {
+
+	if strings.HasPrefix(filepath.ToSlash(relativePath), APPLICATION_AUTHORIZED_APIS.String()+"/") {
+		return APPLICATION_AUTHORIZED_APIS
+	}
+	return resourceType
+}
+
+func PrintPlan(plans []ResourceTypePlan) {
+
+	counts := make(map[PlanAction]int)
+	for _, plan := range plans {
+		fmt.Println("========================================")
+		fmt.Println(plan.ResourceType)
+		fmt.Println("========================================")
+		if plan.Skipped {
+			fmt.Printf("Skipped - %s\n", plan.SkipReason)
+			continue
+		}
+		if plan.Error != "" {
+			fmt.Printf("Failed - %s\n", plan.Error)
+			continue
+		}
+		if len(plan.Changes) == 0 {
+			fmt.Println("No resources found.")
+			continue
+		}
+		for _, change := range plan.Changes {
+			counts[change.Action]++
+			fmt.Printf("%s %s\n", planActionSymbol(change.Action), change.ResourceName)
+			for _, diff := range change.FieldDiffs {
+				fmt.Printf("      %s: %s -> %s\n", diff.Path, FormatDiffValue(diff.Deployed), FormatDiffValue(diff.Local))
+			}
+		}
+	}
+
+	fmt.Println("========================================")
+	fmt.Println("Plan Summary:")
+	fmt.Println("========================================")
+	fmt.Printf("To Create: %d\n", counts[PLAN_CREATE])
+	fmt.Printf("To Update: %d\n", counts[PLAN_UPDATE])
+	fmt.Printf("To Delete: %d\n", counts[PLAN_DELETE])
+	fmt.Printf("Unchanged: %d\n", counts[PLAN_NO_OP])
+	fmt.Println("========================================")
+}
+
+func planActionSymbol(action PlanAction) string {
+
+	switch action {
+	case PLAN_CREATE:
+		return "  + create"
+	case PLAN_UPDATE:
+		return "  ~ update"
+	case PLAN_DELETE:
+		return "  - delete"
+	default:
+		return "    no-op "
+	}
+}
@@ -0,0 +1,198 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const PROMOTE_DIR_PREFIX = "iamctl-promote-"
+
+// Keyword values shorter than this are not replaced, as they are likely to appear in unrelated values.
+const MIN_KEYWORD_PLACEHOLDER_VALUE_LENGTH = 4
+
+type keywordValue struct {
+	keyword string
+	value   string
+}
+
+// Replaces the values of the keyword mappings in the files of a directory with their keyword placeholders, so that
+// the resources exported from an environment can be imported with the keyword mappings of another environment.
+// The resource specific keyword mappings are used for the files of the resources they are configured for.
+// A value is only replaced where it is not part of a longer word, e.g. "dev" is not replaced in "developer".
+// Returns the number of files modified.
+func AddKeywordPlaceholders(dirPath string) (int, error) {
+
+	resourceMappings, err := getResourceFileKeywordMappings(dirPath)
+	if err != nil {
+		return 0, err
+	}
+	warned := make(map[string]bool)
+	defaultKeywordValues := getReversedKeywordMapping(KEYWORD_CONFIGS.KeywordMappings, warned)
+
+	modified := 0
+	err = filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		keywordValues := defaultKeywordValues
+		if keywordMapping, exists := resourceMappings[path]; exists {
+			keywordValues = getReversedKeywordMapping(keywordMapping, warned)
+		}
+		if len(keywordValues) == 0 {
+			return nil
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("error reading %s: %w", path, err)
+		}
+		replaced := string(content)
+		for _, keywordValue := range keywordValues {
+			replaced = replaceTokens(replaced, keywordValue.value, "{{"+keywordValue.keyword+"}}")
+		}
+		if replaced == string(content) {
+			return nil
+		}
+		if err := ioutil.WriteFile(path, []byte(replaced), info.Mode()); err != nil {
+			return fmt.Errorf("error writing %s: %w", path, err)
+		}
+		modified++
+		return nil
+	})
+	return modified, err
+}
+
+// Returns the keyword mappings of the resource files with resource specific keyword mappings, by the file path.
+func getResourceFileKeywordMappings(dirPath string) (map[string]map[string]interface{}, error) {
+
+	mappings := make(map[string]map[string]interface{})
+	for _, resourceType := range ResourceOrder {
+		files, err := ListResourceFiles(filepath.Join(dirPath, resourceType.String()))
+		if err != nil {
+			return nil, fmt.Errorf("error reading the exported %s: %w", resourceType, err)
+		}
+		for relativePath, filePath := range files {
+			configType, resourceName := ResolveResourceFromPath(resourceType, relativePath)
+			if hasResourceKeywordMapping(configType, resourceName) {
+				mappings[filePath] = GetResourceKeywordMapping(configType, resourceName)
+			}
+		}
+	}
+	return mappings, nil
+}
+
+func hasResourceKeywordMapping(resourceType ResourceType, resourceName string) bool {
+
+	resourceConfigs, ok := GetResourceKeywordConfigs(resourceType)[resourceName].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	_, ok = resourceConfigs[KEYWORD_MAPPINGS_CONFIG].(map[string]interface{})
+	return ok
+}
+
+// Returns the keywords of the string values in the keyword mapping, with the longest values first so that a value
+// containing another value is replaced as a whole. A value mapped by several keywords is replaced with the first
+// keyword in the alphabetical order. Each warning is logged once, as recorded in the given warnings.
+func getReversedKeywordMapping(keywordMapping map[string]interface{}, warned map[string]bool) []keywordValue {
+
+	warn := func(message string) {
+		if !warned[message] {
+			warned[message] = true
+			PrintLog(LogLevelWarn, UtilsResourceWrapper, "", message)
+		}
+	}
+
+	keywords := make([]string, 0, len(keywordMapping))
+	for keyword := range keywordMapping {
+		keywords = append(keywords, keyword)
+	}
+	sort.Strings(keywords)
+
+	var keywordValues []keywordValue
+	seenValues := make(map[string]string)
+	for _, keyword := range keywords {
+		value, ok := keywordMapping[keyword].(string)
+		if !ok || value == "" {
+			continue
+		}
+		if len(value) < MIN_KEYWORD_PLACEHOLDER_VALUE_LENGTH {
+			warn(fmt.Sprintf("The value of keyword %s is shorter than %d characters and is not replaced with the keyword.",
+				keyword, MIN_KEYWORD_PLACEHOLDER_VALUE_LENGTH))
+			continue
+		}
+		if previous, exists := seenValues[value]; exists {
+			warn(fmt.Sprintf("Keywords %s and %s have the same value. The value is replaced with %s.",
+				previous, keyword, previous))
+			continue
+		}
+		seenValues[value] = keyword
+		keywordValues = append(keywordValues, keywordValue{keyword: keyword, value: value})
+	}
+	sort.SliceStable(keywordValues, func(i, j int) bool {
+		return len(keywordValues[i].value) > len(keywordValues[j].value)
+	})
+	return keywordValues
+}
+
+// Replaces the occurrences of a value that are not part of a longer word or of a keyword placeholder. The boundaries
+// of each occurrence are checked against the whole content, so that the characters around it are not lost.
+func replaceTokens(content, value, replacement string) string {
+
+	var builder strings.Builder
+	written, offset := 0, 0
+	for {
+		index := strings.Index(content[offset:], value)
+		if index < 0 {
+			break
+		}
+		start := offset + index
+		end := start + len(value)
+		if isTokenBoundary(content, start, end) {
+			builder.WriteString(content[written:start])
+			builder.WriteString(replacement)
+			written, offset = end, end
+		} else {
+			offset = start + 1
+		}
+	}
+	builder.WriteString(content[written:])
+	return builder.String()
+}
+
+func isTokenBoundary(content string, start, end int) bool {
+
+	if start > 0 && isWordCharacter(content[start-1]) && isWordCharacter(content[start]) {
+		return false
+	}
+	if end < len(content) && isWordCharacter(content[end]) && isWordCharacter(content[end-1]) {
+		return false
+	}
+	return !strings.HasSuffix(content[:start], "{{") || !strings.HasPrefix(content[end:], "}}")
+}
+
+func isWordCharacter(c byte) bool {
+
+	return c == '_' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
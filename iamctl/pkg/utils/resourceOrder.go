@@ -18,32 +18,40 @@
 
 package utils
 
+type ResourceTypeDependency struct {
+	ResourceType ResourceType
+	DependsOn    []ResourceType
+}
+
 /*
-* ResourceOrder defines the sequence in which resources should be processed during
-* export and import operations.
-*
-* IMPORTANT: This order is critical because some resources may have dependencies on others.
+* RESOURCE_TYPE_DEPENDENCIES declares the resource types each resource type depends on.
+* A resource type is always processed after the resource types it depends on during export and import operations.
+* Resource types without dependencies between them are processed in the order they are declared here.
  */
-var ResourceOrder = []ResourceType{
-	USERSTORES,
-	CLAIMS,             // Dependency: User Stores
-	IDENTITY_PROVIDERS, // Dependency: Claims, User Stores
-	API_RESOURCES,
-	APPLICATIONS, // Dependency: Claims, User Stores, Identity Providers, API Resources
-	OIDC_SCOPES,
-	ROLES, // Dependency: Applications
-	CHALLENGE_QUESTIONS,
-	EMAIL_TEMPLATES,
-	SMS_TEMPLATES,
-	EMAIL_PROVIDERS,
-	SMS_PROVIDERS,
-	SCRIPT_LIBRARIES,
-	GOVERNANCE_CONNECTORS, // Dependency: Roles
-	CERTIFICATES,
-	WORKFLOWS, // Dependency: Roles
-	VALIDATION_RULES,
-	ACTIONS, // Dependency: Applications, Claims
-	ORGANIZATIONS,
-	BRANDING,
-	FLOWS, // Dependency: Claims, Identity Providers, Governance Connectors
+var RESOURCE_TYPE_DEPENDENCIES = []ResourceTypeDependency{
+	{USERSTORES, nil},
+	{CLAIMS, []ResourceType{USERSTORES}},
+	{IDENTITY_PROVIDERS, []ResourceType{CLAIMS, USERSTORES}},
+	{API_RESOURCES, nil},
+	{APPLICATIONS, []ResourceType{CLAIMS, USERSTORES, IDENTITY_PROVIDERS, API_RESOURCES}},
+	{OIDC_SCOPES, nil},
+	{ROLES, []ResourceType{APPLICATIONS}},
+	{CHALLENGE_QUESTIONS, nil},
+	{EMAIL_TEMPLATES, nil},
+	{SMS_TEMPLATES, nil},
+	{EMAIL_PROVIDERS, nil},
+	{SMS_PROVIDERS, nil},
+	{SCRIPT_LIBRARIES, nil},
+	{GOVERNANCE_CONNECTORS, []ResourceType{ROLES}},
+	{CERTIFICATES, nil},
+	{WORKFLOWS, []ResourceType{ROLES}},
+	{VALIDATION_RULES, nil},
+	{ACTIONS, []ResourceType{APPLICATIONS, CLAIMS}},
+	{ORGANIZATIONS, nil},
+	{BRANDING, nil},
+	{FLOWS, []ResourceType{CLAIMS, IDENTITY_PROVIDERS, GOVERNANCE_CONNECTORS}},
 }
+
+// ResourceOrder defines the sequence in which resources should be processed during export and import operations.
+// It is derived from the dependencies declared in RESOURCE_TYPE_DEPENDENCIES.
+var ResourceOrder = mustSortResourceTypes(RESOURCE_TYPE_DEPENDENCIES)
@@ -21,8 +21,8 @@ package utils
 import (
 	"fmt"
 	"io/ioutil"
-	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 )
 
@@ -59,6 +59,15 @@ func IsResourceExcluded(resourceName string, resourceConfigs map[string]interfac
 
 func IsResourceTypeExcluded(resourceType ResourceType) bool {
 
+	if isResourceTypeExcluded(resourceType) {
+		PrintLog(LogLevelInfo, resourceType, "", "Skipping excluded resource type")
+		return true
+	}
+	return false
+}
+
+func isResourceTypeExcluded(resourceType ResourceType) bool {
+
 	// Include only the resource types added to INCLUDE_ONLY config. Note: INCLUDE_ONLY config overrides the EXCLUDE config.
 	if len(TOOL_CONFIGS.IncludeOnly) > 0 {
 		for _, resource := range TOOL_CONFIGS.IncludeOnly {
@@ -66,13 +75,11 @@ func IsResourceTypeExcluded(resourceType ResourceType) bool {
 				return false
 			}
 		}
-		PrintLog(LogLevelInfo, resourceType, "", "Skipping excluded resource type")
 		return true
 	} else if len(TOOL_CONFIGS.Exclude) > 0 {
 		// Exclude resource types added to EXCLUDE config.
 		for _, resource := range TOOL_CONFIGS.Exclude {
 			if resource == resourceType.String() {
-				PrintLog(LogLevelInfo, resourceType, "", "Skipping excluded resource type")
 				return true
 			}
 		}
@@ -162,7 +169,7 @@ func RemoveDeletedLocalDirectories(parentDir string, deployedDirNames []string)
 		}
 		if _, exists := deployedNames[entry.Name()]; !exists {
 			dirPath := filepath.Join(parentDir, entry.Name())
-			if err := os.RemoveAll(dirPath); err != nil {
+			if err := RemoveLocalDirectory(dirPath); err != nil {
 				PrintLog(LogLevelError, UtilsResourceWrapper, "", fmt.Sprintf("Error when removing the directory %s: %s", entry.Name(), err))
 			} else {
 				PrintLog(LogLevelInfo, UtilsResourceWrapper, "", fmt.Sprintf("Removed the directory: %s", entry.Name()))
@@ -186,7 +193,7 @@ func RemoveDeletedLocalResources(filePath string, deployedResourceNames []string
 		}
 		fileName := file.Name()
 		if !Contains(deployedResourceNames, GetFileInfo(fileName).ResourceName) {
-			err := os.Remove(filepath.Join(filePath, fileName))
+			err := RemoveLocalPath(filepath.Join(filePath, fileName))
 			if err != nil {
 				PrintLog(LogLevelError, UtilsResourceWrapper, "", fmt.Sprintf("Error when removing the file: %s %s", fileName, err))
 			} else {
@@ -202,3 +209,219 @@ func RemoveSecretMasks(modifiedFileData string) string {
 	modifiedFileData = strings.ReplaceAll(modifiedFileData, `"`+SENSITIVE_FIELD_MASK_WITHOUT_QUOTES+`"`, "null")
 	return modifiedFileData
 }
+
+// Returns the name of the local file of a resource, without the file extension.
+// Application role names are escaped, since they contain a path separator.
+func GetFileNameFromResourceName(resourceType ResourceType, resourceName string) string {
+
+	if resourceType == ROLES {
+		return strings.ReplaceAll(resourceName, "Application/", "Application%2F")
+	}
+	return resourceName
+}
+
+func GetResourceNameFromFileName(resourceType ResourceType, fileName string) string {
+
+	if resourceType == ROLES {
+		return strings.ReplaceAll(fileName, "Application%2F", "Application/")
+	}
+	return fileName
+}
+
+func GetResourceToolConfigs(resourceType ResourceType) map[string]interface{} {
+
+	if resourceConfigs := getResourceToolConfigsRef(resourceType); resourceConfigs != nil {
+		return *resourceConfigs
+	}
+	return nil
+}
+
+// Limits the tool configs to the given resource type and, if a resource name is given, to the given resource.
+// Overrides the INCLUDE_ONLY and EXCLUDE configs of the tool configs.
+func IncludeOnlyResource(resourceType ResourceType, resourceName string) error {
+
+	selection := map[ResourceType][]string{resourceType: nil}
+	if resourceName != "" {
+		selection[resourceType] = []string{resourceName}
+	}
+	return IncludeOnlyResources(selection)
+}
+
+// Limits the tool configs to the given resource types and, for the resource types with resource names, to the
+// given resources. Overrides the INCLUDE_ONLY and EXCLUDE configs of the tool configs.
+func IncludeOnlyResources(selection map[ResourceType][]string) error {
+
+	TOOL_CONFIGS.IncludeOnly = nil
+	TOOL_CONFIGS.Exclude = nil
+	for resourceType, resourceNames := range selection {
+		TOOL_CONFIGS.IncludeOnly = append(TOOL_CONFIGS.IncludeOnly, resourceType.String())
+		if len(resourceNames) == 0 {
+			continue
+		}
+
+		if !IsResourceSelectionSupported(resourceType) {
+			return fmt.Errorf("resource type %s does not support selecting resources by name", resourceType)
+		}
+		resourceConfigs := getResourceToolConfigsRef(resourceType)
+		if *resourceConfigs == nil {
+			*resourceConfigs = make(map[string]interface{})
+		}
+		includeOnly := make([]interface{}, len(resourceNames))
+		for i, resourceName := range resourceNames {
+			includeOnly[i] = resourceName
+		}
+		(*resourceConfigs)[INCLUDE_ONLY_CONFIG] = includeOnly
+	}
+	sort.Strings(TOOL_CONFIGS.IncludeOnly)
+	return nil
+}
+
+// Checks whether the resources of a resource type can be included or excluded by name.
+// Validation rules and branding preferences are managed as a single resource per organization.
+func IsResourceSelectionSupported(resourceType ResourceType) bool {
+
+	return getResourceToolConfigsRef(resourceType) != nil && resourceType != VALIDATION_RULES && resourceType != BRANDING_PREFERENCES
+}
+
+// Excludes the given resources of a resource type, in addition to the resources already excluded by the tool configs.
+func ExcludeResources(resourceType ResourceType, resourceNames []string) error {
+
+	if len(resourceNames) == 0 {
+		return nil
+	}
+	if !IsResourceSelectionSupported(resourceType) {
+		return fmt.Errorf("resource type %s does not support excluding resources by name", resourceType)
+	}
+	resourceConfigs := getResourceToolConfigsRef(resourceType)
+	if *resourceConfigs == nil {
+		*resourceConfigs = make(map[string]interface{})
+	}
+
+	// INCLUDE_ONLY config overrides the EXCLUDE config, hence the resources are removed from it instead.
+	if includeOnly, ok := (*resourceConfigs)[INCLUDE_ONLY_CONFIG].([]interface{}); ok {
+		remaining := []interface{}{}
+		for _, resource := range includeOnly {
+			if name, ok := resource.(string); !ok || !Contains(resourceNames, name) {
+				remaining = append(remaining, resource)
+			}
+		}
+		(*resourceConfigs)[INCLUDE_ONLY_CONFIG] = remaining
+		return nil
+	}
+	exclude, _ := (*resourceConfigs)[EXCLUDE_CONFIG].([]interface{})
+	for _, resourceName := range resourceNames {
+		exclude = append(exclude, resourceName)
+	}
+	(*resourceConfigs)[EXCLUDE_CONFIG] = exclude
+	return nil
+}
+
+func getResourceToolConfigsRef(resourceType ResourceType) *map[string]interface{} {
+
+	switch resourceType {
+	case APPLICATIONS:
+		return &TOOL_CONFIGS.ApplicationConfigs
+	case IDENTITY_PROVIDERS:
+		return &TOOL_CONFIGS.IdpConfigs
+	case CLAIMS:
+		return &TOOL_CONFIGS.ClaimConfigs
+	case USERSTORES:
+		return &TOOL_CONFIGS.UserStoreConfigs
+	case OIDC_SCOPES:
+		return &TOOL_CONFIGS.OidcScopeConfigs
+	case ROLES:
+		return &TOOL_CONFIGS.RoleConfigs
+	case CHALLENGE_QUESTIONS:
+		return &TOOL_CONFIGS.ChallengeQuestionConfigs
+	case EMAIL_TEMPLATES:
+		return &TOOL_CONFIGS.EmailTemplateConfigs
+	case SCRIPT_LIBRARIES:
+		return &TOOL_CONFIGS.ScriptLibraryConfigs
+	case GOVERNANCE_CONNECTORS:
+		return &TOOL_CONFIGS.GovernanceConnectorConfigs
+	case CERTIFICATES:
+		return &TOOL_CONFIGS.CertificateConfigs
+	case WORKFLOWS:
+		return &TOOL_CONFIGS.WorkflowConfigs
+	case API_RESOURCES:
+		return &TOOL_CONFIGS.ApiResourceConfigs
+	case VALIDATION_RULES:
+		return &TOOL_CONFIGS.ValidationRuleConfigs
+	case EMAIL_PROVIDERS:
+		return &TOOL_CONFIGS.EmailProviderConfigs
+	case SMS_PROVIDERS:
+		return &TOOL_CONFIGS.SmsProviderConfigs
+	case SMS_TEMPLATES:
+		return &TOOL_CONFIGS.SmsTemplateConfigs
+	case ACTIONS:
+		return &TOOL_CONFIGS.ActionConfigs
+	case ORGANIZATIONS:
+		return &TOOL_CONFIGS.OrganizationConfigs
+	case BRANDING_PREFERENCES:
+		return &TOOL_CONFIGS.BrandingPreferenceConfigs
+	case CUSTOM_TEXTS:
+		return &TOOL_CONFIGS.CustomTextConfigs
+	case FLOWS:
+		return &TOOL_CONFIGS.FlowConfigs
+	}
+	return nil
+}
+
+func GetResourceKeywordConfigs(resourceType ResourceType) map[string]interface{} {
+
+	switch resourceType {
+	case APPLICATIONS:
+		return KEYWORD_CONFIGS.ApplicationConfigs
+	case IDENTITY_PROVIDERS:
+		return KEYWORD_CONFIGS.IdpConfigs
+	case CLAIMS:
+		return KEYWORD_CONFIGS.ClaimConfigs
+	case USERSTORES:
+		return KEYWORD_CONFIGS.UserStoreConfigs
+	case OIDC_SCOPES:
+		return KEYWORD_CONFIGS.OidcScopeConfigs
+	case ROLES:
+		return KEYWORD_CONFIGS.RoleConfigs
+	case CHALLENGE_QUESTIONS:
+		return KEYWORD_CONFIGS.ChallengeQuestionConfigs
+	case EMAIL_TEMPLATES:
+		return KEYWORD_CONFIGS.EmailTemplateConfigs
+	case SCRIPT_LIBRARIES:
+		return KEYWORD_CONFIGS.ScriptLibraryConfigs
+	case GOVERNANCE_CONNECTORS:
+		return KEYWORD_CONFIGS.GovernanceConnectorConfigs
+	case CERTIFICATES:
+		return KEYWORD_CONFIGS.CertificateConfigs
+	case WORKFLOWS:
+		return KEYWORD_CONFIGS.WorkflowConfigs
+	case API_RESOURCES:
+		return KEYWORD_CONFIGS.ApiResourceConfigs
+	case VALIDATION_RULES:
+		return KEYWORD_CONFIGS.ValidationRuleConfigs
+	case EMAIL_PROVIDERS:
+		return KEYWORD_CONFIGS.EmailProviderConfigs
+	case SMS_PROVIDERS:
+		return KEYWORD_CONFIGS.SmsProviderConfigs
+	case SMS_TEMPLATES:
+		return KEYWORD_CONFIGS.SmsTemplateConfigs
+	case ACTIONS:
+		return KEYWORD_CONFIGS.ActionConfigs
+	case ORGANIZATIONS:
+		return KEYWORD_CONFIGS.OrganizationConfigs
+	case BRANDING_PREFERENCES:
+		return KEYWORD_CONFIGS.BrandingPreferenceConfigs
+	case CUSTOM_TEXTS:
+		return KEYWORD_CONFIGS.CustomTextConfigs
+	case FLOWS:
+		return KEYWORD_CONFIGS.FlowConfigs
+	}
+	return nil
+}
+
+func GetResourceKeywordMapping(resourceType ResourceType, resourceName string) map[string]interface{} {
+
+	if resourceConfigs := GetResourceKeywordConfigs(resourceType); resourceConfigs != nil {
+		return ResolveAdvancedKeywordMapping(resourceName, resourceConfigs)
+	}
+	return KEYWORD_CONFIGS.KeywordMappings
+}
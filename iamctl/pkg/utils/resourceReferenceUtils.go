@@ -21,12 +21,16 @@ package utils
 import (
 	"fmt"
 	"strings"
+	"sync"
 )
 
 type ResourceIdentifierMap map[ResourceType]map[string]string
 
 var resourceIdentifierMap = make(ResourceIdentifierMap)
 
+// Guards the resource identifier map, as resources may be processed concurrently.
+var identifierMapLock sync.RWMutex
+
 func ExtractAndRegisterIdentifier(resourceType ResourceType, resourceData interface{}, operation string) {
 
 	resourceMeta, exists := RESOURCE_IDENTIFIER_METADATA[resourceType]
@@ -48,6 +52,9 @@ func ExtractAndRegisterIdentifier(resourceType ResourceType, resourceData interf
 
 func AddToIdentifierMap(resourceType ResourceType, idValue, uniqueValue, operation string) {
 
+	identifierMapLock.Lock()
+	defer identifierMapLock.Unlock()
+
 	if resourceIdentifierMap[resourceType] == nil {
 		resourceIdentifierMap[resourceType] = make(map[string]string)
 	}
@@ -69,10 +76,12 @@ func ReplaceReferences(resourceType ResourceType, resourceData interface{}) (int
 
 	for _, refData := range references {
 		referencedType := refData.ReferencedResourceType
-		identifierMap := resourceIdentifierMap[referencedType]
+		lookup := func(key string) (string, bool) {
+			return GetResourceIdentifier(referencedType, key)
+		}
 
 		for _, refPath := range refData.ReferencePaths {
-			err := replaceReferenceValue(resourceData, refPath, identifierMap)
+			err := replaceReferenceValue(resourceData, refPath, lookup)
 			if err != nil {
 				return nil, fmt.Errorf("error replacing references for referenced type %s: %w", referencedType, err)
 			}
@@ -82,7 +91,18 @@ func ReplaceReferences(resourceType ResourceType, resourceData interface{}) (int
 	return resourceData, nil
 }
 
-func replaceReferenceValue(resourceData interface{}, refPath string, identifierMap map[string]string) error {
+// Returns the identifier mapped to the given key, if any.
+type identifierLookup func(key string) (string, bool)
+
+func mapLookup(identifierMap map[string]string) identifierLookup {
+
+	return func(key string) (string, bool) {
+		value, exists := identifierMap[key]
+		return value, exists
+	}
+}
+
+func replaceReferenceValue(resourceData interface{}, refPath string, lookup identifierLookup) error {
 
 	concretePaths, err := ResolveAllItemsPaths(resourceData, refPath)
 	if err != nil {
@@ -90,7 +110,7 @@ func replaceReferenceValue(resourceData interface{}, refPath string, identifierM
 	}
 
 	for _, path := range concretePaths {
-		err := ReplaceValueAtPath(resourceData, path, identifierMap)
+		err := replaceValueAtPath(resourceData, path, lookup)
 		if err != nil {
 			return err
 		}
@@ -101,6 +121,11 @@ func replaceReferenceValue(resourceData interface{}, refPath string, identifierM
 
 func ReplaceValueAtPath(resourceData interface{}, path string, identifierMap map[string]string) error {
 
+	return replaceValueAtPath(resourceData, path, mapLookup(identifierMap))
+}
+
+func replaceValueAtPath(resourceData interface{}, path string, lookup identifierLookup) error {
+
 	rawValue := getRawValue(resourceData, path)
 	if rawValue == nil {
 		return nil
@@ -111,13 +136,13 @@ func ReplaceValueAtPath(resourceData interface{}, path string, identifierMap map
 		if v == "" {
 			return nil
 		}
-		newValue, exists := identifierMap[v]
+		newValue, exists := lookup(v)
 		if !exists {
 			return fmt.Errorf("referenced resource with identifier '%s' has not been successfully handled", v)
 		}
 		ReplaceValue(resourceData, path, newValue)
 	case []interface{}:
-		newArray, err := ReplaceArrayReferences(v, identifierMap, path)
+		newArray, err := replaceArrayReferences(v, lookup, path)
 		if err != nil {
 			return err
 		}
@@ -131,6 +156,11 @@ func ReplaceValueAtPath(resourceData interface{}, path string, identifierMap map
 
 func ReplaceArrayReferences(arrayVal []interface{}, identifierMap map[string]string, refPath string) ([]interface{}, error) {
 
+	return replaceArrayReferences(arrayVal, mapLookup(identifierMap), refPath)
+}
+
+func replaceArrayReferences(arrayVal []interface{}, lookup identifierLookup, refPath string) ([]interface{}, error) {
+
 	newArray := make([]interface{}, len(arrayVal))
 
 	for i, elem := range arrayVal {
@@ -143,7 +173,7 @@ func ReplaceArrayReferences(arrayVal []interface{}, identifierMap map[string]str
 			continue
 		}
 
-		newValue, exists := identifierMap[strElem]
+		newValue, exists := lookup(strElem)
 		if !exists {
 			return nil, fmt.Errorf("referenced resource with identifier '%s' has not been successfully handled", strElem)
 		}
@@ -225,10 +255,66 @@ func containsAllItemsWildcard(pathString string) bool {
 
 func ResetResourceIdentifierMap() {
 
+	identifierMapLock.Lock()
+	defer identifierMapLock.Unlock()
+
 	resourceIdentifierMap = make(ResourceIdentifierMap)
 }
 
+// Returns the identifier registered for the given key of a resource type, without copying the identifier map.
+func GetResourceIdentifier(resourceType ResourceType, key string) (string, bool) {
+
+	identifierMapLock.RLock()
+	defer identifierMapLock.RUnlock()
+
+	value, exists := resourceIdentifierMap[resourceType][key]
+	return value, exists
+}
+
+// Returns a copy of the identifier map of a resource type. Use GetResourceIdentifier to look up a single identifier.
 func GetResourceIdentifierMap(resourceType ResourceType) map[string]string {
 
-	return resourceIdentifierMap[resourceType]
+	identifierMapLock.RLock()
+	defer identifierMapLock.RUnlock()
+
+	identifierMap, exists := resourceIdentifierMap[resourceType]
+	if !exists {
+		return nil
+	}
+	identifierMapCopy := make(map[string]string, len(identifierMap))
+	for key, value := range identifierMap {
+		identifierMapCopy[key] = value
+	}
+	return identifierMapCopy
+}
+
+func getIdentifierMapSnapshot() ResourceIdentifierMap {
+
+	identifierMapLock.RLock()
+	defer identifierMapLock.RUnlock()
+
+	snapshot := make(ResourceIdentifierMap, len(resourceIdentifierMap))
+	for resourceType, identifierMap := range resourceIdentifierMap {
+		snapshot[resourceType] = make(map[string]string, len(identifierMap))
+		for key, value := range identifierMap {
+			snapshot[resourceType][key] = value
+		}
+	}
+	return snapshot
+}
+
+// Adds the entries of the given identifier map to the resource identifier map.
+func restoreIdentifierMap(identifierMap ResourceIdentifierMap) {
+
+	identifierMapLock.Lock()
+	defer identifierMapLock.Unlock()
+
+	for resourceType, entries := range identifierMap {
+		if resourceIdentifierMap[resourceType] == nil {
+			resourceIdentifierMap[resourceType] = make(map[string]string)
+		}
+		for key, value := range entries {
+			resourceIdentifierMap[resourceType][key] = value
+		}
+	}
 }
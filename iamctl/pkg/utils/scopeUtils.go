@@ -0,0 +1,236 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"sort"
+	"strings"
+)
+
+// Rollback imports the resources of a snapshot and deletes the resources created after it.
+const ROLLBACK = "rollback"
+
+type ResourceTypeScopes struct {
+	View   []string
+	Create []string
+	Update []string
+	Delete []string
+}
+
+// Scopes required to manage each resource type, including the scopes of the organization management APIs.
+var RESOURCE_TYPE_SCOPES = map[ResourceType]ResourceTypeScopes{
+	APPLICATIONS: {
+		View: []string{"internal_application_mgt_view", "internal_org_application_mgt_view",
+			"internal_application_mgt_client_secret_view", "internal_org_application_mgt_client_secret_view"},
+		Create: []string{"internal_application_mgt_create", "internal_org_application_mgt_create",
+			"internal_application_mgt_client_secret_create", "internal_org_application_mgt_client_secret_create"},
+		Update: []string{"internal_application_mgt_update", "internal_org_application_mgt_update",
+			"internal_application_script_update", "internal_org_application_script_update",
+			"internal_application_business_api_update", "internal_application_internal_api_update",
+			"internal_org_application_business_api_update", "internal_org_application_internal_api_update"},
+		Delete: []string{"internal_application_mgt_delete", "internal_org_application_mgt_delete"},
+	},
+	IDENTITY_PROVIDERS: {
+		View:   []string{"internal_idp_view", "internal_org_idp_view"},
+		Create: []string{"internal_idp_create", "internal_org_idp_create"},
+		Update: []string{"internal_idp_update", "internal_org_idp_update"},
+		Delete: []string{"internal_idp_delete", "internal_org_idp_delete"},
+	},
+	CLAIMS: {
+		View:   []string{"internal_claim_meta_view", "internal_org_claim_meta_view"},
+		Create: []string{"internal_claim_meta_create"},
+		Update: []string{"internal_claim_meta_update", "internal_org_claim_meta_update"},
+		Delete: []string{"internal_claim_meta_delete"},
+	},
+	USERSTORES: {
+		View:   []string{"internal_userstore_view", "internal_org_userstore_view"},
+		Create: []string{"internal_userstore_create", "internal_org_userstore_create"},
+		Update: []string{"internal_userstore_update", "internal_org_userstore_update"},
+		Delete: []string{"internal_userstore_delete", "internal_org_userstore_delete"},
+	},
+	OIDC_SCOPES: {
+		View:   []string{"internal_oidc_scope_mgt_view"},
+		Create: []string{"internal_oidc_scope_mgt_create"},
+		Update: []string{"internal_oidc_scope_mgt_update"},
+		Delete: []string{"internal_oidc_scope_mgt_delete"},
+	},
+	ROLES: {
+		View:   []string{"internal_role_mgt_view"},
+		Create: []string{"internal_role_mgt_create"},
+		Update: []string{"internal_role_mgt_update", "internal_role_mgt_permissions_update"},
+		Delete: []string{"internal_role_mgt_delete"},
+	},
+	CHALLENGE_QUESTIONS: {
+		View:   []string{"internal_identity_mgt_view"},
+		Create: []string{"internal_identity_mgt_create"},
+		Update: []string{"internal_identity_mgt_update"},
+		Delete: []string{"internal_identity_mgt_delete"},
+	},
+	EMAIL_TEMPLATES: {
+		View:   []string{"internal_email_mgt_view"},
+		Create: []string{"internal_email_mgt_create"},
+		Update: []string{"internal_email_mgt_update"},
+		Delete: []string{"internal_email_mgt_delete"},
+	},
+	SCRIPT_LIBRARIES: {
+		View:   []string{"internal_functional_lib_view"},
+		Create: []string{"internal_functional_lib_create"},
+		Update: []string{"internal_functional_lib_update"},
+		Delete: []string{"internal_functional_lib_delete"},
+	},
+	GOVERNANCE_CONNECTORS: {
+		View:   []string{"internal_governance_view"},
+		Update: []string{"internal_governance_update"},
+	},
+	CERTIFICATES: {
+		View:   []string{"internal_keystore_view"},
+		Create: []string{"internal_keystore_create"},
+		Update: []string{"internal_keystore_update"},
+		Delete: []string{"internal_keystore_delete"},
+	},
+	WORKFLOWS: {
+		View:   []string{"internal_workflow_view", "internal_workflow_association_view"},
+		Create: []string{"internal_workflow_create", "internal_workflow_association_create"},
+		Update: []string{"internal_workflow_update", "internal_workflow_association_update"},
+		Delete: []string{"internal_workflow_delete", "internal_workflow_association_delete"},
+	},
+	API_RESOURCES: {
+		View:   []string{"internal_api_resource_view"},
+		Create: []string{"internal_api_resource_create"},
+		Update: []string{"internal_api_resource_update"},
+		Delete: []string{"internal_api_resource_delete"},
+	},
+	// Validation rules are read and updated with the same scope.
+	VALIDATION_RULES: {
+		View:   []string{"internal_validation_rule_mgt_update"},
+		Update: []string{"internal_validation_rule_mgt_update"},
+	},
+	ORGANIZATIONS: {
+		View:   []string{"internal_organization_view"},
+		Create: []string{"internal_organization_create"},
+		Update: []string{"internal_organization_update"},
+		Delete: []string{"internal_organization_delete"},
+	},
+	EMAIL_PROVIDERS: {
+		View:   []string{"internal_notification_senders_view"},
+		Create: []string{"internal_notification_senders_create"},
+		Update: []string{"internal_notification_senders_update"},
+		Delete: []string{"internal_notification_senders_delete"},
+	},
+	SMS_PROVIDERS: {
+		View:   []string{"internal_notification_senders_view"},
+		Create: []string{"internal_notification_senders_create"},
+		Update: []string{"internal_notification_senders_update"},
+		Delete: []string{"internal_notification_senders_delete"},
+	},
+	SMS_TEMPLATES: {
+		View:   []string{"internal_template_mgt_view"},
+		Create: []string{"internal_template_mgt_create"},
+		Update: []string{"internal_template_mgt_update"},
+		Delete: []string{"internal_template_mgt_delete"},
+	},
+	ACTIONS: {
+		View:   []string{"internal_action_mgt_view"},
+		Create: []string{"internal_action_mgt_create"},
+		Update: []string{"internal_action_mgt_update"},
+		Delete: []string{"internal_action_mgt_delete"},
+	},
+	// Branding is read without a scope, and created, updated and deleted with the same scope.
+	BRANDING_PREFERENCES: {
+		Create: []string{"internal_branding_preference_update"},
+		Update: []string{"internal_branding_preference_update"},
+		Delete: []string{"internal_branding_preference_update"},
+	},
+	CUSTOM_TEXTS: {
+		Create: []string{"internal_branding_preference_update"},
+		Update: []string{"internal_branding_preference_update"},
+		Delete: []string{"internal_branding_preference_update"},
+	},
+	FLOWS: {
+		View:   []string{"internal_flow_view"},
+		Update: []string{"internal_flow_update"},
+	},
+}
+
+// Resolves the scopes needed for the given operation on the resource types selected by the tool configs.
+// Exports only read the target environment, while imports create and update resources, and delete them
+// if deleting is allowed.
+func GetRequiredScopes(operation string) []string {
+
+	scopeSet := make(map[string]bool)
+	addScopes := func(scopes []string) {
+		for _, scope := range scopes {
+			scopeSet[scope] = true
+		}
+	}
+
+	var resourceTypes []ResourceType
+	for _, resourceType := range ResourceOrder {
+		if resourceType == BRANDING {
+			resourceTypes = append(resourceTypes, BRANDING_PREFERENCES, CUSTOM_TEXTS)
+		} else {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+
+	for _, resourceType := range resourceTypes {
+		if isResourceTypeExcluded(resourceType) {
+			continue
+		}
+		scopes := RESOURCE_TYPE_SCOPES[resourceType]
+		addScopes(scopes.View)
+		if operation == IMPORT || operation == ROLLBACK {
+			addScopes(scopes.Create)
+			addScopes(scopes.Update)
+			if operation == ROLLBACK || TOOL_CONFIGS.AllowDelete {
+				addScopes(scopes.Delete)
+			}
+			// The deployed resources referred to by the imported resources are read to resolve their identifiers.
+			for _, dependency := range GetResourceTypeDependencies(resourceType) {
+				addScopes(RESOURCE_TYPE_SCOPES[dependency].View)
+			}
+			for _, reference := range RESOURCE_REFERENCE_METADATA[resourceType] {
+				addScopes(RESOURCE_TYPE_SCOPES[reference.ReferencedResourceType].View)
+			}
+		}
+	}
+
+	requiredScopes := make([]string, 0, len(scopeSet))
+	for scope := range scopeSet {
+		requiredScopes = append(requiredScopes, scope)
+	}
+	sort.Strings(requiredScopes)
+	return requiredScopes
+}
+
+// Returns the required scopes that were not granted, given the scope of an access token response.
+func GetMissingScopes(requiredScopes []string, grantedScope string) []string {
+
+	granted := make(map[string]bool)
+	for _, scope := range strings.Fields(grantedScope) {
+		granted[scope] = true
+	}
+	var missingScopes []string
+	for _, scope := range requiredScopes {
+		if !granted[scope] {
+			missingScopes = append(missingScopes, scope)
+		}
+	}
+	return missingScopes
+}
@@ -0,0 +1,281 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// State of the tool for an environment: the configs, the HTTP client, the access token, the server capabilities,
+// the resource identifiers, the summary, and the state of the run such as the checkpoint, the correlation ID,
+// the concurrency, the interrupt contexts, and the trace and cassette files. The state is copied from and to the
+// package-level variables, so a new package-level variable used by the resource packages should be added here as
+// well, or it is shared by all the sessions.
+type sessionState struct {
+	serverConfigs                  ServerConfigs
+	toolConfigs                    ToolConfigs
+	keywordConfigs                 KeywordConfigs
+	logLevel                       LogLevel
+	httpClient                     *http.Client
+	httpConfigs                    HttpConfigs
+	tlsConfig                      *tls.Config
+	tokenRefreshAt                 time.Time
+	requestedScopes                []string
+	serverVersionDeclared          bool
+	probedCapabilities             map[string]bool
+	rolesV2ApiExists               bool
+	notificationTemplatesApiExists bool
+	identifierMap                  ResourceIdentifierMap
+	aggregatedSummary              Summary
+	resTypeSummaryMap              map[ResourceType]ResourceTypeSummary
+	warnings                       []string
+	resTypeStartTimes              map[ResourceType]time.Time
+	startTime                      time.Time
+	dryRun                         bool
+	checkpoint                     *Checkpoint
+	checkpointFilePath             string
+	correlationId                  string
+	concurrency                    int
+	resourceSlots                  chan struct{}
+	runContext                     context.Context
+	cancelRun                      context.CancelFunc
+	requestContext                 context.Context
+	cancelRequests                 context.CancelFunc
+	traceFile                      *os.File
+	cassetteMode                   string
+	cassetteFile                   *os.File
+	replayInteractions             []CassetteInteraction
+	replayed                       []bool
+}
+
+// A session with an environment. The package-level state, such as SERVER_CONFIGS and the summary, is not replaced
+// by the session: the resource packages still work with it. A session keeps a copy of that state, which is swapped
+// into the package-level variables while its operations are run. Hence only one session is active at a time, and
+// the operations of different sessions are run one after the other, never concurrently. Run and SummaryReport should
+// not be called from within a Run of any session, as they wait for the active session to complete.
+// A session starts with the correlation ID, concurrency, interrupt handling, tracing and recording active when it is
+// created, and the changes made to them while its operations are run are kept in the session.
+type Session struct {
+	state   sessionState
+	baseDir string
+}
+
+// Guards the active state of the tool while the operations of a session are run.
+var sessionLock sync.Mutex
+
+// Creates a session by loading the configs of the environment and getting an access token for the operation.
+func NewSession(envConfigPath string, operation string) (*Session, error) {
+
+	sessionLock.Lock()
+	defer sessionLock.Unlock()
+
+	previous := captureSessionState()
+	defer restoreSessionState(previous)
+
+	restoreSessionState(newSessionState(previous))
+	baseDir, err := LoadConfigs(envConfigPath, operation)
+	if err != nil {
+		return nil, err
+	}
+	return &Session{state: captureSessionState(), baseDir: baseDir}, nil
+}
+
+// Runs the given function with the state of the session activated. The changes made to the state, such as the
+// summary and the registered resource identifiers, are kept in the session.
+func (session *Session) Run(operation func()) {
+
+	sessionLock.Lock()
+	defer sessionLock.Unlock()
+
+	previous := captureSessionState()
+	restoreSessionState(session.state)
+	defer func() {
+		session.state = captureSessionState()
+		restoreSessionState(previous)
+	}()
+	operation()
+}
+
+// Returns the base directory of the environment, where the resources are exported to by default.
+func (session *Session) BaseDir() string {
+
+	return session.baseDir
+}
+
+// Returns the summary report of the operations run in the session.
+func (session *Session) SummaryReport(operation string) (report SummaryReport) {
+
+	session.Run(func() {
+		report = BuildSummaryReport(operation)
+	})
+	return report
+}
+
+// Clears the summary of the session, so that the next operations are reported on their own.
+func (session *Session) ResetSummary() {
+
+	session.Run(ResetSummary)
+}
+
+func (session *Session) SetDryRun(dryRun bool) {
+
+	session.Run(func() {
+		DryRun = dryRun
+	})
+}
+
+// Creates the state of a new session, with the state of the run inherited from the given active state.
+func newSessionState(active sessionState) sessionState {
+
+	return sessionState{
+		logLevel:           LogLevelInfo,
+		httpConfigs:        DefaultHttpConfigs(),
+		identifierMap:      make(ResourceIdentifierMap),
+		resTypeStartTimes:  make(map[ResourceType]time.Time),
+		correlationId:      active.correlationId,
+		concurrency:        active.concurrency,
+		resourceSlots:      active.resourceSlots,
+		runContext:         active.runContext,
+		cancelRun:          active.cancelRun,
+		requestContext:     active.requestContext,
+		cancelRequests:     active.cancelRequests,
+		traceFile:          active.traceFile,
+		cassetteMode:       active.cassetteMode,
+		cassetteFile:       active.cassetteFile,
+		replayInteractions: active.replayInteractions,
+		replayed:           active.replayed,
+	}
+}
+
+func captureSessionState() sessionState {
+
+	state := sessionState{
+		serverConfigs:                  SERVER_CONFIGS,
+		toolConfigs:                    TOOL_CONFIGS,
+		keywordConfigs:                 KEYWORD_CONFIGS,
+		logLevel:                       CURRENT_LOG_LEVEL,
+		tlsConfig:                      tlsConfig,
+		serverVersionDeclared:          serverVersionDeclared,
+		rolesV2ApiExists:               RolesV2ApiExists,
+		notificationTemplatesApiExists: NotificationTemplatesApiExists,
+		dryRun:                         DryRun,
+		correlationId:                  correlationId,
+		concurrency:                    Concurrency,
+		resourceSlots:                  resourceSlots,
+	}
+
+	httpClientLock.Lock()
+	state.httpClient, state.httpConfigs = httpClient, httpConfigs
+	httpClientLock.Unlock()
+
+	tokenLock.Lock()
+	state.tokenRefreshAt, state.requestedScopes = tokenRefreshAt, requestedScopes
+	tokenLock.Unlock()
+
+	capabilityLock.RLock()
+	state.probedCapabilities = probedCapabilities
+	capabilityLock.RUnlock()
+
+	identifierMapLock.RLock()
+	state.identifierMap = resourceIdentifierMap
+	identifierMapLock.RUnlock()
+
+	summaryLock.Lock()
+	state.aggregatedSummary, state.resTypeSummaryMap, state.warnings = AggregatedSummary, ResTypeSummaryMap, Warnings
+	state.resTypeStartTimes, state.startTime = ResTypeStartTimes, StartTime
+	summaryLock.Unlock()
+
+	checkpointLock.Lock()
+	state.checkpoint, state.checkpointFilePath = checkpoint, checkpointFilePath
+	checkpointLock.Unlock()
+
+	interruptLock.Lock()
+	state.runContext, state.cancelRun = runContext, cancelRun
+	state.requestContext, state.cancelRequests = requestContext, cancelRequests
+	interruptLock.Unlock()
+
+	traceLock.Lock()
+	state.traceFile = traceFile
+	traceLock.Unlock()
+
+	cassetteLock.Lock()
+	state.cassetteMode, state.cassetteFile = cassetteMode, cassetteFile
+	state.replayInteractions, state.replayed = replayInteractions, replayed
+	cassetteLock.Unlock()
+	return state
+}
+
+func restoreSessionState(state sessionState) {
+
+	SERVER_CONFIGS = state.serverConfigs
+	TOOL_CONFIGS = state.toolConfigs
+	KEYWORD_CONFIGS = state.keywordConfigs
+	CURRENT_LOG_LEVEL = state.logLevel
+	tlsConfig = state.tlsConfig
+	serverVersionDeclared = state.serverVersionDeclared
+	RolesV2ApiExists = state.rolesV2ApiExists
+	NotificationTemplatesApiExists = state.notificationTemplatesApiExists
+	DryRun = state.dryRun
+	correlationId = state.correlationId
+	Concurrency, resourceSlots = state.concurrency, state.resourceSlots
+
+	httpClientLock.Lock()
+	httpClient, httpConfigs = state.httpClient, state.httpConfigs
+	httpClientLock.Unlock()
+
+	tokenLock.Lock()
+	tokenRefreshAt, requestedScopes = state.tokenRefreshAt, state.requestedScopes
+	tokenLock.Unlock()
+
+	capabilityLock.Lock()
+	probedCapabilities = state.probedCapabilities
+	capabilityLock.Unlock()
+
+	identifierMapLock.Lock()
+	resourceIdentifierMap = state.identifierMap
+	identifierMapLock.Unlock()
+
+	summaryLock.Lock()
+	AggregatedSummary, ResTypeSummaryMap, Warnings = state.aggregatedSummary, state.resTypeSummaryMap, state.warnings
+	ResTypeStartTimes, StartTime = state.resTypeStartTimes, state.startTime
+	summaryLock.Unlock()
+
+	checkpointLock.Lock()
+	checkpoint, checkpointFilePath = state.checkpoint, state.checkpointFilePath
+	checkpointLock.Unlock()
+
+	interruptLock.Lock()
+	runContext, cancelRun = state.runContext, state.cancelRun
+	requestContext, cancelRequests = state.requestContext, state.cancelRequests
+	interruptLock.Unlock()
+
+	traceLock.Lock()
+	traceFile = state.traceFile
+	traceLock.Unlock()
+
+	cassetteLock.Lock()
+	cassetteMode, cassetteFile = state.cassetteMode, state.cassetteFile
+	replayInteractions, replayed = state.replayInteractions, state.replayed
+	cassetteLock.Unlock()
+}
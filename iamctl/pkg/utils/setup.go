@@ -20,11 +20,9 @@ package utils
 
 import (
 	"bytes"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"net/url"
 	"os"
@@ -46,13 +44,27 @@ type oAuthResponse struct {
 }
 
 type ServerConfigs struct {
-	ServerUrl     string `json:"SERVER_URL"`
-	ClientId      string `json:"CLIENT_ID"`
-	ClientSecret  string `json:"CLIENT_SECRET"`
-	TenantDomain  string `json:"TENANT_DOMAIN"`
-	Organization  string `json:"ORGANIZATION"`
-	Token         string `json:"TOKEN"`
-	ServerVersion string `json:"SERVER_VERSION"`
+	ServerUrl          string   `json:"SERVER_URL"`
+	ClientId           string   `json:"CLIENT_ID"`
+	ClientSecret       string   `json:"CLIENT_SECRET"`
+	TenantDomain       string   `json:"TENANT_DOMAIN"`
+	Organization       string   `json:"ORGANIZATION"`
+	Token              string   `json:"TOKEN"`
+	ServerVersion      string   `json:"SERVER_VERSION"`
+	InsecureSkipVerify bool     `json:"INSECURE_SKIP_VERIFY"`
+	CaCertPath         string   `json:"CA_CERT_PATH"`
+	ClientCertPath     string   `json:"CLIENT_CERT_PATH"`
+	ClientKeyPath      string   `json:"CLIENT_KEY_PATH"`
+	PinnedCertificates []string `json:"PINNED_CERTIFICATES"`
+	ClientAuthMethod   string   `json:"CLIENT_AUTH_METHOD"`
+	PrivateKeyPath     string   `json:"PRIVATE_KEY_PATH"`
+	PrivateKeyId       string   `json:"PRIVATE_KEY_ID"`
+	GrantType          string   `json:"GRANT_TYPE"`
+	SubjectToken       string   `json:"SUBJECT_TOKEN"`
+	SubjectTokenFile   string   `json:"SUBJECT_TOKEN_FILE"`
+	SubjectTokenType   string   `json:"SUBJECT_TOKEN_TYPE"`
+	AccessToken        string   `json:"ACCESS_TOKEN"`
+	AccessTokenFile    string   `json:"ACCESS_TOKEN_FILE"`
 }
 
 type ToolConfigs struct {
@@ -83,6 +95,7 @@ type ToolConfigs struct {
 	CustomTextConfigs          map[string]interface{} `json:"CUSTOM_TEXTS"`
 	FlowConfigs                map[string]interface{} `json:"FLOWS"`
 	Logs                       LogsConfig             `json:"LOGS"`
+	Http                       HttpConfigs            `json:"HTTP"`
 }
 
 type KeywordConfigs struct {
@@ -115,20 +128,43 @@ var SERVER_CONFIGS ServerConfigs
 var TOOL_CONFIGS ToolConfigs
 var KEYWORD_CONFIGS KeywordConfigs
 
-func LoadConfigs(envConfigPath string) (baseDir string) {
+// Loads the configs and gets an access token with the scopes needed for the given operation. Returns a ConfigError
+// if the configs cannot be loaded, and an AuthError if an access token cannot be obtained.
+func LoadConfigs(envConfigPath string, operation string) (baseDir string, err error) {
 
-	baseDir, toolConfigFile, keywordConfigPath := loadServerConfigs(envConfigPath)
-	TOOL_CONFIGS = loadToolConfigsFromFile(toolConfigFile)
+	baseDir, toolConfigFile, keywordConfigPath, err := loadServerConfigs(envConfigPath)
+	if err != nil {
+		return "", err
+	}
+	if TOOL_CONFIGS, err = loadToolConfigsFromFile(toolConfigFile); err != nil {
+		return "", err
+	}
 	CURRENT_LOG_LEVEL = resolveLogLevel(TOOL_CONFIGS.Logs.LogLevel)
-	KEYWORD_CONFIGS = loadKeywordConfigsFromFile(keywordConfigPath)
-	return baseDir
+	InitHTTPClient(TOOL_CONFIGS.Http)
+	PrintLog(LogLevelInfo, UtilsResourceWrapper, "", "Correlation ID: "+GetCorrelationId())
+
+	// Get access token.
+	if err := InitAccessToken(GetRequiredScopes(operation)); err != nil {
+		return "", err
+	}
+	PrintLog(LogLevelInfo, UtilsResourceWrapper, "", "Access Token received successfully.")
+	if err := initServerCapabilities(); err != nil {
+		return "", err
+	}
+
+	if KEYWORD_CONFIGS, err = loadKeywordConfigsFromFile(keywordConfigPath); err != nil {
+		return "", err
+	}
+	return baseDir, nil
 }
 
-func loadServerConfigs(envConfigPath string) (baseDir string, toolConfigPath string, keywordConfigPath string) {
+func loadServerConfigs(envConfigPath string) (baseDir string, toolConfigPath string, keywordConfigPath string, err error) {
 
 	if envConfigPath == "" {
 		PrintLog(LogLevelInfo, UtilsResourceWrapper, "", "Loading configs from environment variables.")
-		toolConfigPath, keywordConfigPath = loadConfigsFromEnvVar()
+		if toolConfigPath, keywordConfigPath, err = loadConfigsFromEnvVar(); err != nil {
+			return "", "", "", err
+		}
 		baseDir = filepath.Dir(filepath.Dir(filepath.Dir(toolConfigPath)))
 	} else {
 		PrintLog(LogLevelInfo, UtilsResourceWrapper, "", "Loading configs from config files.")
@@ -137,27 +173,30 @@ func loadServerConfigs(envConfigPath string) (baseDir string, toolConfigPath str
 		toolConfigPath = filepath.Join(envConfigPath, TOOL_CONFIG_FILE)
 		keywordConfigPath = filepath.Join(envConfigPath, KEYWORD_CONFIG_FILE)
 
-		SERVER_CONFIGS = loadServerConfigsFromFile(serverConfigFile)
+		if SERVER_CONFIGS, err = loadServerConfigsFromFile(serverConfigFile); err != nil {
+			return "", "", "", err
+		}
 	}
 	sanitizeServerConfigs()
 
+	if err := InitTLSConfig(SERVER_CONFIGS); err != nil {
+		return "", "", "", &ConfigError{Message: "error in the TLS configs", Err: err}
+	}
+
 	// Validate server version format
 	if SERVER_CONFIGS.ServerVersion != "" {
-		_, err := ParseVersion(SERVER_CONFIGS.ServerVersion)
-		if err != nil {
-			PrintLog(LogLevelError, UtilsResourceWrapper, "", fmt.Sprintf("Error parsing server version: %s. Error: %s", SERVER_CONFIGS.ServerVersion, err))
-			log.Fatalln("ERROR: Utils - Unexpected format for Server Version.")
+		if _, err := ParseVersion(SERVER_CONFIGS.ServerVersion); err != nil {
+			return "", "", "", &ConfigError{
+				Message: fmt.Sprintf("unexpected format for the server version: %s", SERVER_CONFIGS.ServerVersion),
+				Err:     err,
+			}
 		}
 	}
 
-	// Get access token.
-	SERVER_CONFIGS.Token = getAccessToken(SERVER_CONFIGS)
-	PrintLog(LogLevelInfo, UtilsResourceWrapper, "", "Access Token received successfully.")
-
-	return baseDir, toolConfigPath, keywordConfigPath
+	return baseDir, toolConfigPath, keywordConfigPath, nil
 }
 
-func loadConfigsFromEnvVar() (toolConfigPath string, keywordConfigPath string) {
+func loadConfigsFromEnvVar() (toolConfigPath string, keywordConfigPath string, err error) {
 
 	// Load server configs from environment variables.
 	SERVER_CONFIGS.ServerUrl = os.Getenv(SERVER_URL_CONFIG)
@@ -165,23 +204,31 @@ func loadConfigsFromEnvVar() (toolConfigPath string, keywordConfigPath string) {
 	SERVER_CONFIGS.ClientSecret = os.Getenv(CLIENT_SECRET_CONFIG)
 	SERVER_CONFIGS.TenantDomain = os.Getenv(TENANT_DOMAIN_CONFIG)
 	SERVER_CONFIGS.Organization = os.Getenv(ORGANIZATION_CONFIG)
-	serverVersion, exists := os.LookupEnv(SERVER_VERSION_CONFIG)
-	if !exists {
-		log.Fatalln("ERROR: Utils - Server Version environment variable is not set.")
+	SERVER_CONFIGS.ServerVersion, serverVersionDeclared = os.LookupEnv(SERVER_VERSION_CONFIG)
+	if err := LoadTLSConfigsFromEnvVar(&SERVER_CONFIGS); err != nil {
+		return "", "", &ConfigError{Message: "error in the TLS configs", Err: err}
 	}
-	SERVER_CONFIGS.ServerVersion = serverVersion
+	SERVER_CONFIGS.ClientAuthMethod = os.Getenv(CLIENT_AUTH_METHOD_CONFIG)
+	SERVER_CONFIGS.PrivateKeyPath = os.Getenv(PRIVATE_KEY_PATH_CONFIG)
+	SERVER_CONFIGS.PrivateKeyId = os.Getenv(PRIVATE_KEY_ID_CONFIG)
+	SERVER_CONFIGS.GrantType = os.Getenv(GRANT_TYPE_CONFIG)
+	SERVER_CONFIGS.SubjectToken = os.Getenv(SUBJECT_TOKEN_CONFIG)
+	SERVER_CONFIGS.SubjectTokenFile = os.Getenv(SUBJECT_TOKEN_FILE_CONFIG)
+	SERVER_CONFIGS.SubjectTokenType = os.Getenv(SUBJECT_TOKEN_TYPE_CONFIG)
+	SERVER_CONFIGS.AccessToken = os.Getenv(ACCESS_TOKEN_CONFIG)
+	SERVER_CONFIGS.AccessTokenFile = os.Getenv(ACCESS_TOKEN_FILE_CONFIG)
 
 	// Load tool config file path from environment variables.
 	toolConfigPath = os.Getenv(TOOL_CONFIG_PATH)
 	keywordConfigPath = os.Getenv(KEYWORD_CONFIG_PATH)
-	return toolConfigPath, keywordConfigPath
+	return toolConfigPath, keywordConfigPath, nil
 }
 
-func loadServerConfigsFromFile(configFilePath string) (serverConfigs ServerConfigs) {
+func loadServerConfigsFromFile(configFilePath string) (serverConfigs ServerConfigs, err error) {
 
 	configFile, err := ioutil.ReadFile(configFilePath)
 	if err != nil {
-		log.Fatalln("ERROR: Utils -", err.Error())
+		return serverConfigs, &ConfigError{Message: "error when reading the server config file", Err: err}
 	}
 
 	// Replace placeholder keys with environment variable values
@@ -189,169 +236,150 @@ func loadServerConfigsFromFile(configFilePath string) (serverConfigs ServerConfi
 
 	var rawMap map[string]json.RawMessage
 	if err = json.Unmarshal(configFile, &rawMap); err != nil {
-		log.Fatalln("ERROR: Utils -", err)
-	}
-	if _, exists := rawMap[SERVER_VERSION_CONFIG]; !exists {
-		log.Fatalln("ERROR: Utils - Server Version is missing from the server config file.")
+		return serverConfigs, &ConfigError{Message: "server configs are not in the correct format", Err: err}
 	}
+	_, serverVersionDeclared = rawMap[SERVER_VERSION_CONFIG]
 
 	reader := bytes.NewReader(configFile)
 	jsonParser := json.NewDecoder(reader)
-	err = jsonParser.Decode(&serverConfigs)
-	if err != nil {
-		log.Fatalln("ERROR: Utils -", err)
+	if err = jsonParser.Decode(&serverConfigs); err != nil {
+		return serverConfigs, &ConfigError{Message: "server configs are not in the correct format", Err: err}
 	}
 	PrintLog(LogLevelInfo, UtilsResourceWrapper, "", "Server configs loaded successfully from the config file.")
-	return serverConfigs
+	return serverConfigs, nil
 }
 
-func loadToolConfigsFromFile(configFilePath string) (toolConfigs ToolConfigs) {
+func loadToolConfigsFromFile(configFilePath string) (toolConfigs ToolConfigs, err error) {
 
 	configFile, err := ioutil.ReadFile(configFilePath)
 	if err != nil {
-		log.Fatalln("ERROR: Utils -", "Error when reading the tool config file.", err.Error())
+		return toolConfigs, &ConfigError{Message: "error when reading the tool config file", Err: err}
 	}
 
 	toolConfigs.ExcludeSecrets = true
+	toolConfigs.Http = DefaultHttpConfigs()
 	if len(configFile) == 0 {
-		return toolConfigs
+		return toolConfigs, nil
 	}
 
-	err = json.Unmarshal(configFile, &toolConfigs)
-	if err != nil {
-		log.Fatalln("ERROR: Utils - Tool configs are not in the correct format. Please check the config file.", err)
+	if err = json.Unmarshal(configFile, &toolConfigs); err != nil {
+		return toolConfigs, &ConfigError{Message: "tool configs are not in the correct format. Please check the config file", Err: err}
 	}
 
 	PrintLog(LogLevelInfo, UtilsResourceWrapper, "", "Tool configs loaded successfully from the config file.")
-	return toolConfigs
+	return toolConfigs, nil
 }
 
-func loadKeywordConfigsFromFile(configFilePath string) (keywordConfigs KeywordConfigs) {
+func loadKeywordConfigsFromFile(configFilePath string) (keywordConfigs KeywordConfigs, err error) {
 
 	configFile, err := ioutil.ReadFile(configFilePath)
 	if err != nil {
-		log.Fatalln("ERROR: Utils - Error when reading the keyword config file.", err.Error())
+		return keywordConfigs, &ConfigError{Message: "error when reading the keyword config file", Err: err}
 	}
 
 	if len(configFile) == 0 {
-		return keywordConfigs
+		return keywordConfigs, nil
 	}
 
 	// Replace placeholder keys with environment variable values
 	configFile = ReplacePlaceholders(configFile)
 
-	err = json.Unmarshal(configFile, &keywordConfigs)
-	if err != nil {
-		log.Fatalln("ERROR: Utils - Keyword configs are not in the correct format. Please check the config file.", err)
+	if err = json.Unmarshal(configFile, &keywordConfigs); err != nil {
+		return keywordConfigs, &ConfigError{Message: "keyword configs are not in the correct format. Please check the config file", Err: err}
 	}
 
 	PrintLog(LogLevelInfo, UtilsResourceWrapper, "", "Keyword configs loaded successfully from the config file.")
-	return keywordConfigs
+	return keywordConfigs, nil
 }
 
-func getAccessToken(config ServerConfigs) string {
+func getAccessToken(config ServerConfigs) (oAuthResponse, error) {
 
-	var err error
 	var response oAuthResponse
-
 	if config.ServerUrl == "" {
-		log.Fatalln("ERROR: Utils -", "Server URL is not defined in the config file.")
+		return response, &ConfigError{Message: "server URL is not defined in the server configs"}
 	}
-	authUrl := config.ServerUrl + "/t/" + config.TenantDomain + "/oauth2/token"
-
-	body := url.Values{}
-	body.Set("grant_type", "client_credentials")
-	body.Set("scope", SCOPE)
-
-	req, err := http.NewRequest("POST", authUrl, strings.NewReader(body.Encode()))
-	if err != nil {
-		log.Fatalln("ERROR: Utils -", err)
+	if err := validateAuthConfigs(config); err != nil {
+		return response, &ConfigError{Message: "invalid client authentication configs", Err: err}
 	}
-	req.SetBasicAuth(config.ClientId, config.ClientSecret)
-	req.Header.Set("Content-Type", MEDIA_TYPE_FORM)
-	defer req.Body.Close()
 
-	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		log.Fatalln("ERROR: Utils -", err)
+	// A pre-issued access token is used as it is, as it is expected to be issued for the target organization.
+	if token, preIssued, err := getPreIssuedToken(config); preIssued {
+		response.AccessToken = token
+		if err != nil {
+			return response, &ConfigError{Message: "error reading the access token", Err: err}
+		}
+		return response, nil
 	}
-	defer resp.Body.Close()
 
-	respBody, err := ioutil.ReadAll(resp.Body)
+	body, err := getGrantParams(config)
 	if err != nil {
-		log.Fatalln("ERROR: Utils -", err)
+		return response, &ConfigError{Message: "error in getting access token", Err: err}
 	}
-
-	if resp.StatusCode != 200 {
-		log.Fatalln("ERROR: Utils -", "Error in getting access token, response: "+string(respBody))
-	}
-
-	err2 := json.Unmarshal(respBody, &response)
-	if err2 != nil {
-		log.Fatalln("ERROR: Utils -", err2)
+	response, err = sendTokenRequest(config, body)
+	if err != nil {
+		return response, &AuthError{Message: "error in getting access token", Err: err}
 	}
 	if IsSubOrganization() {
-		PrintLog(LogLevelInfo, UtilsResourceWrapper, "", "Getting access token for Organization: "+SERVER_CONFIGS.Organization)
+		PrintLog(LogLevelInfo, UtilsResourceWrapper, "", "Getting access token for Organization: "+config.Organization)
 		return switchAccessToken(config, response.AccessToken)
 	}
-	return response.AccessToken
+	return response, nil
 }
 
-func switchAccessToken(config ServerConfigs, accessToken string) string {
-
-	var err error
-	var response oAuthResponse
-
-	authUrl := config.ServerUrl + "/t/" + config.TenantDomain + "/oauth2/token"
+func switchAccessToken(config ServerConfigs, accessToken string) (oAuthResponse, error) {
 
 	body := url.Values{}
 	body.Set("grant_type", "organization_switch")
-	body.Set("scope", SCOPE)
+	body.Set("scope", getRequestedScope())
 	body.Set("token", accessToken)
 	body.Set("switching_organization", config.Organization)
 
-	req, err := http.NewRequest("POST", authUrl, strings.NewReader(body.Encode()))
+	response, err := sendTokenRequest(config, body)
 	if err != nil {
-		log.Fatalln("ERROR: Utils -", err)
+		return response, &AuthError{Message: "error in switching access token", Err: err}
+	}
+	return response, nil
+}
+
+func sendTokenRequest(config ServerConfigs, body url.Values) (oAuthResponse, error) {
+
+	var response oAuthResponse
+	authUrl := config.ServerUrl + "/t/" + config.TenantDomain + "/oauth2/token"
+
+	basicAuth, err := addClientAuthentication(config, authUrl, body)
+	if err != nil {
+		return response, err
+	}
+
+	req, err := http.NewRequestWithContext(RequestContext(), "POST", authUrl, strings.NewReader(body.Encode()))
+	if err != nil {
+		return response, err
+	}
+	if basicAuth {
+		req.SetBasicAuth(config.ClientId, config.ClientSecret)
 	}
-	req.SetBasicAuth(config.ClientId, config.ClientSecret)
 	req.Header.Set("Content-Type", MEDIA_TYPE_FORM)
 	defer req.Body.Close()
 
-	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-	}
-	resp, err := httpClient.Do(req)
+	resp, err := doRequest(req)
 	if err != nil {
-		log.Fatalln("ERROR: Utils -", err)
+		return response, err
 	}
 	defer resp.Body.Close()
 
 	respBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		log.Fatalln("ERROR: Utils -", err)
+		return response, err
 	}
-
 	if resp.StatusCode != 200 {
-		log.Fatalln("ERROR: Utils -", "Error in switching access token, response: "+string(respBody))
+		return response, NewServerError(resp.StatusCode, respBody, fmt.Sprintf("error response for the token request: %s %s",
+			resp.Status, string(respBody)))
 	}
 
-	err2 := json.Unmarshal(respBody, &response)
-	if err2 != nil {
-		log.Fatalln("ERROR: Utils -", err2)
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return response, err
 	}
-	return response.AccessToken
+	return response, nil
 }
 
 func sanitizeServerConfigs() {
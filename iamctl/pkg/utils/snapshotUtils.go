@@ -0,0 +1,233 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const (
+	SNAPSHOTS_DIR_NAME     = "snapshots"
+	SNAPSHOT_MANIFEST_FILE = "snapshot.json"
+)
+
+// Records the resources an import is about to change. The deployed state of the updated and deleted resources
+// is exported to the snapshot directory along with the manifest.
+type SnapshotManifest struct {
+	CreatedAt    time.Time                        `json:"createdAt"`
+	ServerUrl    string                           `json:"serverUrl"`
+	TenantDomain string                           `json:"tenantDomain"`
+	Organization string                           `json:"organization,omitempty"`
+	Changes      map[ResourceType]SnapshotChanges `json:"changes"`
+}
+
+type SnapshotChanges struct {
+	Created []string `json:"created,omitempty"`
+	Updated []string `json:"updated,omitempty"`
+	Deleted []string `json:"deleted,omitempty"`
+}
+
+// Returns the default snapshot directory of an import, a timestamped directory in the .iamctl directory of the
+// home directory, so that the snapshots are not written to the input directory.
+func GetDefaultSnapshotDirPath() string {
+
+	parentDirPath, err := os.UserHomeDir()
+	if err != nil {
+		parentDirPath = os.TempDir()
+	}
+	return filepath.Join(parentDirPath, MANIFEST_DIR_NAME, SNAPSHOTS_DIR_NAME, time.Now().Format("20060102-150405"))
+}
+
+// Creates the snapshot of an import from its plan, given that the deployed resources are exported to the
+// snapshot directory. The files of the resources the import does not update or delete are removed.
+func CreateSnapshot(snapshotDirPath string, plans []ResourceTypePlan) (*SnapshotManifest, error) {
+
+	manifest := &SnapshotManifest{
+		CreatedAt:    time.Now(),
+		ServerUrl:    SERVER_CONFIGS.ServerUrl,
+		TenantDomain: SERVER_CONFIGS.TenantDomain,
+		Organization: SERVER_CONFIGS.Organization,
+		Changes:      make(map[ResourceType]SnapshotChanges),
+	}
+
+	for _, plan := range plans {
+		resourceDirPath := filepath.Join(snapshotDirPath, plan.ResourceType.String())
+		if plan.Skipped || len(plan.Changes) == 0 {
+			if err := os.RemoveAll(resourceDirPath); err != nil {
+				return nil, fmt.Errorf("error removing the unchanged resources from the snapshot: %w", err)
+			}
+			continue
+		}
+		files, err := ListResourceFiles(resourceDirPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the snapshot of %s: %w", plan.ResourceType, err)
+		}
+
+		resourceActions := getResourceActions(plan, files)
+		for resource, action := range resourceActions {
+			if action == PLAN_NO_OP {
+				continue
+			}
+			changes := manifest.Changes[resource.ResourceType]
+			switch action {
+			case PLAN_CREATE:
+				changes.Created = append(changes.Created, resource.Name)
+			case PLAN_UPDATE:
+				changes.Updated = append(changes.Updated, resource.Name)
+			case PLAN_DELETE:
+				changes.Deleted = append(changes.Deleted, resource.Name)
+			}
+			manifest.Changes[resource.ResourceType] = changes
+		}
+
+		for relativePath, filePath := range files {
+			configType, resourceName := ResolveResourceFromPath(plan.ResourceType, relativePath)
+			action := resourceActions[ResourceKey{configType, resourceName}]
+			if action != PLAN_UPDATE && action != PLAN_DELETE {
+				if err := os.Remove(filePath); err != nil {
+					return nil, fmt.Errorf("error removing the unchanged resource from the snapshot: %w", err)
+				}
+			}
+		}
+		if err := removeEmptyDirectories(resourceDirPath); err != nil {
+			return nil, fmt.Errorf("error cleaning up the snapshot of %s: %w", plan.ResourceType, err)
+		}
+		// The resource type directory is kept even if empty, so that the created resources are deleted on rollback.
+		if err := os.MkdirAll(resourceDirPath, 0700); err != nil {
+			return nil, fmt.Errorf("error creating the snapshot directory of %s: %w", plan.ResourceType, err)
+		}
+	}
+
+	for resourceType, changes := range manifest.Changes {
+		sort.Strings(changes.Created)
+		sort.Strings(changes.Updated)
+		sort.Strings(changes.Deleted)
+		manifest.Changes[resourceType] = changes
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error serializing the snapshot manifest: %w", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(snapshotDirPath, SNAPSHOT_MANIFEST_FILE), data, 0644); err != nil {
+		return nil, fmt.Errorf("error writing the snapshot manifest: %w", err)
+	}
+	return manifest, nil
+}
+
+// Resolves the change of each resource from the changes of its files. A resource is created or deleted only if
+// all of its files are, and updated if any of its files change otherwise.
+func getResourceActions(plan ResourceTypePlan, deployedFiles map[string]string) map[ResourceKey]PlanAction {
+
+	resourceActions := make(map[ResourceKey]PlanAction)
+	for _, change := range plan.Changes {
+		_, resourceName := ResolveResourceFromPath(plan.ResourceType, change.ResourceName)
+		resource := ResourceKey{change.ResourceType, resourceName}
+		current, exists := resourceActions[resource]
+		switch {
+		case !exists:
+			resourceActions[resource] = change.Action
+		case current != change.Action && change.Action != PLAN_NO_OP:
+			resourceActions[resource] = PLAN_UPDATE
+		case current == PLAN_NO_OP:
+			resourceActions[resource] = PLAN_UPDATE
+		}
+	}
+
+	// A resource is only created if it is not deployed at all, e.g. not when only its sub resources are created.
+	for relativePath := range deployedFiles {
+		configType, resourceName := ResolveResourceFromPath(plan.ResourceType, relativePath)
+		resource := ResourceKey{configType, resourceName}
+		if resourceActions[resource] == PLAN_CREATE {
+			resourceActions[resource] = PLAN_UPDATE
+		}
+	}
+	return resourceActions
+}
+
+func removeEmptyDirectories(dirPath string) error {
+
+	entries, err := ioutil.ReadDir(dirPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		subDirPath := filepath.Join(dirPath, entry.Name())
+		if err := removeEmptyDirectories(subDirPath); err != nil {
+			return err
+		}
+		if subEntries, err := ioutil.ReadDir(subDirPath); err == nil && len(subEntries) == 0 {
+			if err := os.Remove(subDirPath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func LoadSnapshot(snapshotDirPath string) (*SnapshotManifest, error) {
+
+	fileBytes, err := ioutil.ReadFile(filepath.Join(snapshotDirPath, SNAPSHOT_MANIFEST_FILE))
+	if err != nil {
+		return nil, fmt.Errorf("error reading the snapshot manifest: %w", err)
+	}
+	var manifest SnapshotManifest
+	if err := json.Unmarshal(fileBytes, &manifest); err != nil {
+		return nil, fmt.Errorf("error parsing the snapshot manifest: %w", err)
+	}
+	if manifest.ServerUrl != SERVER_CONFIGS.ServerUrl || manifest.TenantDomain != SERVER_CONFIGS.TenantDomain ||
+		manifest.Organization != SERVER_CONFIGS.Organization {
+		return nil, fmt.Errorf("snapshot was created for a different target environment: %s (tenant: %s)",
+			manifest.ServerUrl, manifest.TenantDomain)
+	}
+	return &manifest, nil
+}
+
+// Returns the resources to be imported from the snapshot to roll back the changes. The updated and deleted resources
+// are restored from their snapshot, and the created resources are deleted as they are not in the snapshot.
+func (manifest *SnapshotManifest) GetRollbackSelection() map[ResourceType][]string {
+
+	selection := make(map[ResourceType][]string)
+	for resourceType, changes := range manifest.Changes {
+		if !IsResourceSelectionSupported(resourceType) {
+			selection[resourceType] = nil
+			continue
+		}
+		var resourceNames []string
+		resourceNames = append(resourceNames, changes.Created...)
+		resourceNames = append(resourceNames, changes.Updated...)
+		resourceNames = append(resourceNames, changes.Deleted...)
+		if len(resourceNames) > 0 {
+			selection[resourceType] = resourceNames
+		}
+	}
+	return selection
+}
@@ -0,0 +1,247 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	SUMMARY_FORMAT_TEXT  = "text"
+	SUMMARY_FORMAT_JSON  = "json"
+	SUMMARY_FORMAT_JUNIT = "junit"
+)
+
+// Machine-readable summary of a command.
+type SummaryReport struct {
+	Operation            string                      `json:"operation"`
+	DryRun               bool                        `json:"dryRun"`
+	Interrupted          bool                        `json:"interrupted"`
+	CorrelationId        string                      `json:"correlationId"`
+	StartTime            time.Time                   `json:"startTime"`
+	DurationMs           int64                       `json:"durationMs"`
+	TotalOperations      int                         `json:"totalOperations"`
+	SuccessfulOperations int                         `json:"successfulOperations"`
+	FailedOperations     int                         `json:"failedOperations"`
+	ResourceTypes        []ResourceTypeSummaryReport `json:"resourceTypes"`
+	Warnings             []string                    `json:"warnings"`
+}
+
+type ResourceTypeSummaryReport struct {
+	ResourceType                ResourceType      `json:"resourceType"`
+	Status                      string            `json:"status"`
+	SuccessfulExports           int               `json:"successfulExports,omitempty"`
+	SuccessfulImports           int               `json:"successfulImports,omitempty"`
+	SuccessfulUpdates           int               `json:"successfulUpdates,omitempty"`
+	Deleted                     int               `json:"deleted,omitempty"`
+	FailedCount                 int               `json:"failedCount"`
+	FailedResources             []string          `json:"failedResources,omitempty"`
+	Failures                    []ResourceFailure `json:"failures,omitempty"`
+	SecretGeneratedApplications []string          `json:"secretGeneratedApplications,omitempty"`
+	SkipReason                  string            `json:"skipReason,omitempty"`
+	Interrupted                 bool              `json:"interrupted,omitempty"`
+	DurationMs                  int64             `json:"durationMs"`
+}
+
+const (
+	RESOURCE_TYPE_STATUS_SUCCESS = "success"
+	RESOURCE_TYPE_STATUS_SKIPPED = "skipped"
+	RESOURCE_TYPE_STATUS_FAILED  = "failed"
+)
+
+func ValidateSummaryFormat(format string) error {
+
+	switch format {
+	case SUMMARY_FORMAT_TEXT, SUMMARY_FORMAT_JSON, SUMMARY_FORMAT_JUNIT:
+		return nil
+	}
+	return fmt.Errorf("unsupported summary format: %s. Supported formats: %s, %s, %s", format,
+		SUMMARY_FORMAT_TEXT, SUMMARY_FORMAT_JSON, SUMMARY_FORMAT_JUNIT)
+}
+
+// Builds the summary report of the operations recorded so far. The resource types are sorted by name.
+func BuildSummaryReport(operation string) SummaryReport {
+
+	summaryLock.Lock()
+	defer summaryLock.Unlock()
+
+	report := SummaryReport{
+		Operation:            operation,
+		DryRun:               DryRun,
+		Interrupted:          IsInterrupted(),
+		CorrelationId:        GetCorrelationId(),
+		StartTime:            StartTime,
+		TotalOperations:      AggregatedSummary.TotalRequests,
+		SuccessfulOperations: AggregatedSummary.SuccessfulOperations,
+		FailedOperations:     AggregatedSummary.FailedOperations,
+		ResourceTypes:        []ResourceTypeSummaryReport{},
+		Warnings:             append([]string{}, Warnings...),
+	}
+	if !StartTime.IsZero() {
+		report.DurationMs = durationMs(time.Since(StartTime))
+	}
+
+	for _, summary := range ResTypeSummaryMap {
+		resourceTypeReport := ResourceTypeSummaryReport{
+			ResourceType:                summary.ResourceType,
+			Status:                      RESOURCE_TYPE_STATUS_SUCCESS,
+			SuccessfulExports:           summary.SuccessfulExport,
+			SuccessfulImports:           summary.SuccessfulImport,
+			SuccessfulUpdates:           summary.SuccessfulUpdate,
+			Deleted:                     summary.DeletedCount,
+			FailedCount:                 summary.FailedCount,
+			FailedResources:             append([]string{}, summary.FailedResources...),
+			Failures:                    append([]ResourceFailure{}, summary.Failures...),
+			SecretGeneratedApplications: append([]string{}, summary.SecretGeneratedApplications...),
+			SkipReason:                  summary.SkipReason,
+			Interrupted:                 summary.Interrupted,
+			DurationMs:                  durationMs(summary.Duration),
+		}
+		if summary.Skipped {
+			resourceTypeReport.Status = RESOURCE_TYPE_STATUS_SKIPPED
+		} else if summary.Failed || summary.FailedCount > 0 {
+			resourceTypeReport.Status = RESOURCE_TYPE_STATUS_FAILED
+		}
+		report.ResourceTypes = append(report.ResourceTypes, resourceTypeReport)
+	}
+	sort.Slice(report.ResourceTypes, func(i, j int) bool {
+		return report.ResourceTypes[i].ResourceType < report.ResourceTypes[j].ResourceType
+	})
+	return report
+}
+
+// Serializes the summary report in the given format.
+func FormatSummaryReport(report SummaryReport, format string) ([]byte, error) {
+
+	switch format {
+	case SUMMARY_FORMAT_JSON:
+		return json.MarshalIndent(report, "", "  ")
+	case SUMMARY_FORMAT_JUNIT:
+		data, err := xml.MarshalIndent(buildJUnitReport(report), "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte(xml.Header), append(data, '\n')...), nil
+	}
+	return nil, fmt.Errorf("unsupported summary format: %s", format)
+}
+
+type jUnitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []jUnitTestSuite `xml:"testsuite"`
+}
+
+type jUnitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []jUnitTestCase `xml:"testcase"`
+}
+
+type jUnitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr,omitempty"`
+	Failure   *jUnitMessage `xml:"failure,omitempty"`
+	Skipped   *jUnitMessage `xml:"skipped,omitempty"`
+}
+
+type jUnitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// Maps each resource type to a test suite. The resource type itself is a test case, and each failed resource
+// is an additional failed test case, so that the failed resources can be identified in the test reports.
+func buildJUnitReport(report SummaryReport) jUnitTestSuites {
+
+	suites := jUnitTestSuites{
+		Name: "iamctl " + report.Operation,
+		Time: formatJUnitTime(report.DurationMs),
+	}
+	for _, resourceType := range report.ResourceTypes {
+		suite := jUnitTestSuite{
+			Name: resourceType.ResourceType.String(),
+			Time: formatJUnitTime(resourceType.DurationMs),
+		}
+		testCase := jUnitTestCase{
+			Name:      resourceType.ResourceType.String(),
+			ClassName: resourceType.ResourceType.String(),
+			Time:      formatJUnitTime(resourceType.DurationMs),
+		}
+		switch resourceType.Status {
+		case RESOURCE_TYPE_STATUS_SKIPPED:
+			testCase.Skipped = &jUnitMessage{Message: resourceType.SkipReason}
+		case RESOURCE_TYPE_STATUS_FAILED:
+			message := fmt.Sprintf("Failed to %s the resource type", report.Operation)
+			if resourceType.FailedCount > 0 {
+				message = fmt.Sprintf("%d resources failed to %s", resourceType.FailedCount, report.Operation)
+			}
+			testCase.Failure = &jUnitMessage{Message: message}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+		for i, resourceName := range resourceType.FailedResources {
+			failure := &jUnitMessage{Message: fmt.Sprintf("Failed to %s %s", report.Operation, resourceName)}
+			if i < len(resourceType.Failures) {
+				failure.Text = resourceType.Failures[i].Error
+			}
+			suite.TestCases = append(suite.TestCases, jUnitTestCase{
+				Name:      resourceName,
+				ClassName: resourceType.ResourceType.String(),
+				Failure:   failure,
+			})
+		}
+
+		for _, testCase := range suite.TestCases {
+			suite.Tests++
+			if testCase.Failure != nil {
+				suite.Failures++
+			}
+			if testCase.Skipped != nil {
+				suite.Skipped++
+			}
+		}
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Skipped += suite.Skipped
+		suites.Suites = append(suites.Suites, suite)
+	}
+	return suites
+}
+
+func durationMs(duration time.Duration) int64 {
+
+	return int64(duration / time.Millisecond)
+}
+
+func formatJUnitTime(durationMs int64) string {
+
+	return fmt.Sprintf("%.3f", float64(durationMs)/1000)
+}
@@ -0,0 +1,141 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// TLS configs used for all the requests sent to the server. The server certificate is verified against the
+// system trust store by default.
+var tlsConfig *tls.Config
+
+// Builds the TLS configs from the server configs and uses them for all the requests sent to the server.
+func InitTLSConfig(serverConfigs ServerConfigs) error {
+
+	config, err := BuildTLSConfig(serverConfigs)
+	if err != nil {
+		return err
+	}
+	if serverConfigs.InsecureSkipVerify {
+		PrintLog(LogLevelWarn, UtilsResourceWrapper, "", "TLS certificate verification is disabled. "+
+			"This should only be used for local development.")
+	}
+	tlsConfig = config
+	resetHTTPClient()
+	return nil
+}
+
+func BuildTLSConfig(serverConfigs ServerConfigs) (*tls.Config, error) {
+
+	config := &tls.Config{
+		InsecureSkipVerify: serverConfigs.InsecureSkipVerify,
+	}
+
+	if serverConfigs.CaCertPath != "" {
+		caCerts, err := ioutil.ReadFile(serverConfigs.CaCertPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the CA certificate file: %w", err)
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caCerts) {
+			return nil, fmt.Errorf("no PEM encoded certificates found in the CA certificate file: %s", serverConfigs.CaCertPath)
+		}
+		config.RootCAs = certPool
+	}
+
+	if serverConfigs.ClientCertPath != "" || serverConfigs.ClientKeyPath != "" {
+		if serverConfigs.ClientCertPath == "" || serverConfigs.ClientKeyPath == "" {
+			return nil, errors.New("both the client certificate and the client key are required for mutual TLS")
+		}
+		clientCert, err := tls.LoadX509KeyPair(serverConfigs.ClientCertPath, serverConfigs.ClientKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("error loading the client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{clientCert}
+	}
+
+	if len(serverConfigs.PinnedCertificates) > 0 {
+		pins := make(map[string]bool)
+		for _, pin := range serverConfigs.PinnedCertificates {
+			normalizedPin, err := normalizeCertificatePin(pin)
+			if err != nil {
+				return nil, err
+			}
+			pins[normalizedPin] = true
+		}
+		config.VerifyPeerCertificate = func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+			return verifyPinnedCertificate(rawCerts, pins)
+		}
+	}
+	return config, nil
+}
+
+// Loads the TLS configs from the environment variables.
+func LoadTLSConfigsFromEnvVar(serverConfigs *ServerConfigs) error {
+
+	if value, exists := os.LookupEnv(INSECURE_SKIP_VERIFY_CONFIG); exists && value != "" {
+		insecureSkipVerify, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %s", INSECURE_SKIP_VERIFY_CONFIG, value)
+		}
+		serverConfigs.InsecureSkipVerify = insecureSkipVerify
+	}
+	serverConfigs.CaCertPath = os.Getenv(CA_CERT_PATH_CONFIG)
+	serverConfigs.ClientCertPath = os.Getenv(CLIENT_CERT_PATH_CONFIG)
+	serverConfigs.ClientKeyPath = os.Getenv(CLIENT_KEY_PATH_CONFIG)
+	serverConfigs.PinnedCertificates = nil
+	for _, pin := range strings.Split(os.Getenv(PINNED_CERTIFICATES_CONFIG), ",") {
+		if pin = strings.TrimSpace(pin); pin != "" {
+			serverConfigs.PinnedCertificates = append(serverConfigs.PinnedCertificates, pin)
+		}
+	}
+	return nil
+}
+
+// Pins are SHA-256 fingerprints of the certificates in hex, optionally separated by colons.
+func normalizeCertificatePin(pin string) (string, error) {
+
+	normalizedPin := strings.ToLower(strings.Replace(strings.TrimSpace(pin), ":", "", -1))
+	if decoded, err := hex.DecodeString(normalizedPin); err != nil || len(decoded) != sha256.Size {
+		return "", fmt.Errorf("invalid certificate pin: %s. Expected a SHA-256 fingerprint in hex", pin)
+	}
+	return normalizedPin, nil
+}
+
+// Verifies that a certificate in the chain presented by the server matches one of the pins.
+func verifyPinnedCertificate(rawCerts [][]byte, pins map[string]bool) error {
+
+	for _, rawCert := range rawCerts {
+		fingerprint := sha256.Sum256(rawCert)
+		if pins[hex.EncodeToString(fingerprint[:])] {
+			return nil
+		}
+	}
+	return errors.New("server certificate does not match any of the pinned certificates")
+}
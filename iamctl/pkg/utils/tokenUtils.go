@@ -0,0 +1,119 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Access tokens are refreshed this long before they expire, so that they do not expire while a request is in flight.
+// Short lived tokens are refreshed halfway through their lifetime instead.
+const TOKEN_REFRESH_MARGIN = time.Minute
+
+var (
+	tokenRefreshAt time.Time
+	// Scopes requested for the access token. All the scopes of the tool are requested if not set.
+	requestedScopes []string
+	// Guards the access token in the server configs, as it may be refreshed while resources are processed concurrently.
+	tokenLock sync.Mutex
+)
+
+// Gets an access token with the given scopes for the configured server, switched to the organization for
+// sub organizations. All the scopes of the tool are requested if no scopes are given. Warns if the token is
+// not granted all the requested scopes, as the requests needing them would fail.
+func InitAccessToken(scopes []string) error {
+
+	tokenLock.Lock()
+	defer tokenLock.Unlock()
+
+	requestedScopes = scopes
+	response, err := renewAccessToken()
+	if err != nil {
+		return err
+	}
+	// The granted scopes are unknown for pre-issued access tokens.
+	if response.Scope == "" {
+		return nil
+	}
+	if missingScopes := GetMissingScopes(strings.Fields(getRequestedScope()), response.Scope); len(missingScopes) > 0 {
+		PrintLog(LogLevelWarn, UtilsResourceWrapper, "", "Access token is not granted the following scopes required "+
+			"for the selected resource types. Requests needing them will fail: "+strings.Join(missingScopes, " "))
+	}
+	return nil
+}
+
+func getRequestedScope() string {
+
+	if requestedScopes == nil {
+		return SCOPE
+	}
+	return strings.Join(requestedScopes, " ")
+}
+
+// Returns the access token, refreshed if it is about to expire.
+func getValidAccessToken() (string, error) {
+
+	tokenLock.Lock()
+	defer tokenLock.Unlock()
+
+	if !tokenRefreshAt.IsZero() && time.Now().After(tokenRefreshAt) {
+		PrintLog(LogLevelInfo, UtilsResourceWrapper, "", "Access token is about to expire. Refreshing the access token.")
+		if _, err := renewAccessToken(); err != nil {
+			return "", err
+		}
+	}
+	return SERVER_CONFIGS.Token, nil
+}
+
+// Refreshes the access token rejected by the server. The token is not refreshed again if it was already refreshed
+// by a concurrent request.
+func refreshAccessToken(rejectedToken string) (string, error) {
+
+	tokenLock.Lock()
+	defer tokenLock.Unlock()
+
+	if SERVER_CONFIGS.Token == rejectedToken {
+		PrintLog(LogLevelInfo, UtilsResourceWrapper, "", "Access token was rejected. Refreshing the access token.")
+		if _, err := renewAccessToken(); err != nil {
+			return "", err
+		}
+	}
+	return SERVER_CONFIGS.Token, nil
+}
+
+func renewAccessToken() (oAuthResponse, error) {
+
+	response, err := getAccessToken(SERVER_CONFIGS)
+	if err != nil {
+		return response, err
+	}
+	SERVER_CONFIGS.Token = response.AccessToken
+	tokenRefreshAt = time.Time{}
+	if response.Expires > 0 {
+		lifetime := time.Duration(response.Expires) * time.Second
+		margin := TOKEN_REFRESH_MARGIN
+		if margin > lifetime/2 {
+			margin = lifetime / 2
+		}
+		tokenRefreshAt = time.Now().Add(lifetime - margin)
+	}
+	return response, nil
+}
@@ -0,0 +1,313 @@
+/**
+* Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+*
+* WSO2 LLC. licenses this file to you under the Apache License,
+* Version 2.0 (the "License"); you may not use this file except
+* in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied. See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+)
+
+const (
+	CORRELATION_ID_HEADER = "X-Correlation-ID"
+	REQUEST_ID_HEADER     = "X-Request-ID"
+	REDACTED_VALUE        = "********"
+	TRACE_FILE_CLOSING    = "\n]}}\n"
+)
+
+// Correlation ID of the run, sent with all the requests so that they can be found in the server logs.
+var correlationId = newTraceId()
+
+var (
+	traceFile *os.File
+	traceLock sync.Mutex
+)
+
+// Headers and body values that are not written to the trace file.
+var (
+	redactedHeaders = map[string]bool{
+		"Authorization":       true,
+		"Proxy-Authorization": true,
+		"Cookie":              true,
+		"Set-Cookie":          true,
+	}
+	// Keys containing secret, password or assertion, or ending with token, e.g. clientSecret or access_token.
+	secretKeyPattern        = `(?:[^"'&=\s:]*(?i:secret|password|assertion)[^"'&=\s:]*|[^"'&=\s:]*(?i:token))`
+	secretJsonPattern       = regexp.MustCompile(`("` + secretKeyPattern + `"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	secretFormPattern       = regexp.MustCompile(`((?:^|&)` + secretKeyPattern + `=)[^&]*`)
+	secretYamlPattern       = regexp.MustCompile(`(?m)^(\s*-?\s*` + secretKeyPattern + `:[ \t]+)\S.*$`)
+	secretXmlTagPattern     = regexp.MustCompile(`(<[\w:-]*(?i:secret|password)[\w:-]*(?:\s[^>]*)?>)[^<]*(</[\w:-]+>)`)
+	secretJsonPropertyValue = regexp.MustCompile(`("(?:name|key)"\s*:\s*"[^"]*(?i:secret|password)[^"]*"\s*,\s*"value"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	secretYamlPropertyValue = regexp.MustCompile(`(?m)^(\s*-?\s*(?:name|key):[ \t]*\S*(?i:secret|password)\S*[ \t]*\n\s*value:[ \t]+)\S.*$`)
+)
+
+type traceEntry struct {
+	StartedDateTime time.Time     `json:"startedDateTime"`
+	Time            int64         `json:"time"`
+	Request         traceRequest  `json:"request"`
+	Response        traceResponse `json:"response"`
+	CorrelationId   string        `json:"_correlationId"`
+	RequestId       string        `json:"_requestId,omitempty"`
+	Attempt         int           `json:"_attempt"`
+	Error           string        `json:"_error,omitempty"`
+}
+
+type traceRequest struct {
+	Method   string        `json:"method"`
+	Url      string        `json:"url"`
+	Headers  []traceHeader `json:"headers"`
+	PostData *tracePayload `json:"postData,omitempty"`
+}
+
+type traceResponse struct {
+	Status  int           `json:"status"`
+	Headers []traceHeader `json:"headers"`
+	Content tracePayload  `json:"content"`
+}
+
+type traceHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type tracePayload struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+// Returns the correlation ID of the run.
+func GetCorrelationId() string {
+
+	return correlationId
+}
+
+// Uses the given correlation ID for all the requests sent from now on.
+func SetCorrelationId(id string) {
+
+	correlationId = id
+}
+
+// Returns a random version 4 UUID.
+func newTraceId() string {
+
+	b := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// Starts recording all the requests and responses in a HAR-like trace file at the given path. The file is kept
+// valid after each request, so that the requests sent before the tool exits are not lost.
+func StartTrace(filePath string) error {
+
+	traceLock.Lock()
+	defer traceLock.Unlock()
+
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0600)
+	if err != nil {
+		return fmt.Errorf("error creating the trace file: %w", err)
+	}
+	header := fmt.Sprintf(`{"log":{"version":"1.2","creator":{"name":"iamctl","version":""},"_correlationId":%q,"entries":[`,
+		correlationId)
+	if _, err := file.WriteString(header + TRACE_FILE_CLOSING); err != nil {
+		file.Close()
+		return fmt.Errorf("error writing the trace file: %w", err)
+	}
+	traceFile = file
+	return nil
+}
+
+// Stops recording the requests and closes the trace file.
+func StopTrace() error {
+
+	traceLock.Lock()
+	defer traceLock.Unlock()
+
+	if traceFile == nil {
+		return nil
+	}
+	err := traceFile.Close()
+	traceFile = nil
+	return err
+}
+
+func isTracing() bool {
+
+	traceLock.Lock()
+	defer traceLock.Unlock()
+
+	return traceFile != nil
+}
+
+// Sends a single attempt of a request with the correlation and request IDs, and records it in the trace file
+// if tracing is started.
+func sendTracedRequest(client *http.Client, request *http.Request, requestId string, attempt int) (*http.Response, error) {
+
+	request.Header.Set(CORRELATION_ID_HEADER, correlationId)
+	if requestId != "" {
+		request.Header.Set(REQUEST_ID_HEADER, requestId)
+	}
+	ids := "correlation ID: " + correlationId
+	if requestId != "" {
+		ids += ", request ID: " + requestId
+	}
+	PrintLog(LogLevelDebug, UtilsResourceWrapper, "", fmt.Sprintf("Sending %s %s (%s, attempt: %d)",
+		request.Method, request.URL.Path, ids, attempt+1))
+
+	if !isTracing() {
+		return client.Do(request)
+	}
+
+	var requestBody []byte
+	if request.Body != nil && request.GetBody != nil {
+		if body, err := request.GetBody(); err == nil {
+			requestBody, _ = ioutil.ReadAll(body)
+			body.Close()
+		}
+	}
+	startTime := time.Now()
+	resp, err := client.Do(request)
+	entry := traceEntry{
+		StartedDateTime: startTime,
+		Time:            durationMs(time.Since(startTime)),
+		Request: traceRequest{
+			Method:  request.Method,
+			Url:     request.URL.String(),
+			Headers: getTraceHeaders(request.Header),
+		},
+		CorrelationId: correlationId,
+		RequestId:     requestId,
+		Attempt:       attempt,
+	}
+	if requestBody != nil {
+		entry.Request.PostData = &tracePayload{
+			MimeType: request.Header.Get("Content-Type"),
+			Text:     RedactSecrets(string(requestBody)),
+		}
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		responseBody, readErr := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+		if readErr != nil {
+			entry.Error = readErr.Error()
+			err = fmt.Errorf("error reading the response: %w", readErr)
+		}
+		entry.Response = traceResponse{
+			Status:  resp.StatusCode,
+			Headers: getTraceHeaders(resp.Header),
+			Content: tracePayload{
+				MimeType: resp.Header.Get("Content-Type"),
+				Text:     RedactSecrets(string(responseBody)),
+			},
+		}
+	}
+	if traceErr := writeTraceEntry(entry); traceErr != nil {
+		PrintLog(LogLevelWarn, UtilsResourceWrapper, "", "Error writing the trace file: "+traceErr.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func getTraceHeaders(header http.Header) []traceHeader {
+
+	headers := []traceHeader{}
+	for name, values := range header {
+		for _, value := range values {
+			if redactedHeaders[http.CanonicalHeaderKey(name)] {
+				value = REDACTED_VALUE
+			}
+			headers = append(headers, traceHeader{Name: name, Value: value})
+		}
+	}
+	return headers
+}
+
+func writeTraceEntry(entry traceEntry) error {
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	traceLock.Lock()
+	defer traceLock.Unlock()
+
+	if traceFile == nil {
+		return nil
+	}
+	return appendJsonArrayEntry(traceFile, data, TRACE_FILE_CLOSING)
+}
+
+// Appends an entry to the JSON array at the end of a file, by overwriting the given closing brackets of the file
+// and writing them again after the entry. The file remains valid JSON after each entry.
+func appendJsonArrayEntry(file *os.File, data []byte, closing string) error {
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	offset := info.Size() - int64(len(closing))
+	separator := ","
+	if isJsonArrayEmpty(file, offset) {
+		separator = ""
+	}
+	_, err = file.WriteAt([]byte(separator+"\n"+string(data)+closing), offset)
+	return err
+}
+
+// Checks whether the JSON array of the file is empty, given the offset of its closing bracket.
+func isJsonArrayEmpty(file *os.File, offset int64) bool {
+
+	if offset <= 0 {
+		return true
+	}
+	last := make([]byte, 1)
+	if _, err := file.ReadAt(last, offset-1); err != nil {
+		return true
+	}
+	return last[0] == '['
+}
+
+// Replaces the values of the secret properties in a JSON, YAML, form or XML payload.
+func RedactSecrets(payload string) string {
+
+	payload = secretJsonPattern.ReplaceAllString(payload, `$1"`+REDACTED_VALUE+`"`)
+	payload = secretJsonPropertyValue.ReplaceAllString(payload, `$1"`+REDACTED_VALUE+`"`)
+	payload = secretYamlPropertyValue.ReplaceAllString(payload, "${1}"+REDACTED_VALUE)
+	payload = secretFormPattern.ReplaceAllString(payload, "${1}"+REDACTED_VALUE)
+	payload = secretYamlPattern.ReplaceAllString(payload, "${1}"+REDACTED_VALUE)
+	payload = secretXmlTagPattern.ReplaceAllString(payload, "${1}"+REDACTED_VALUE+"${2}")
+	return payload
+}
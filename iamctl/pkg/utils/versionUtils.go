@@ -28,12 +28,20 @@ import (
 var RolesV2ApiExists bool
 var NotificationTemplatesApiExists bool
 
-// Checks if a resource type is supported in the configured WSO2 IS version.
+// Checks if a resource type is supported in the configured WSO2 IS version, or in the server if probed.
 // Returns true if:
 //   - minimum required version <= Configured version <= maximum supported version for the resource type
 //   - No version requirement is defined for the resource type
 func IsEntitySupportedInVersion(resourceType ResourceType) bool {
 
+	// The probed capabilities of the server take precedence over the version requirements.
+	if available, probed := GetProbedCapability(resourceType.String()); probed {
+		if !available {
+			PrintLog(LogLevelInfo, resourceType, "", "Skipping: Not available in the server")
+		}
+		return available
+	}
+
 	minVersion, hasMin := EntityMinVersionRequirements[resourceType]
 	maxVersion, hasMax := EntityMaxSupportedVersion[resourceType]
 
@@ -20,7 +20,6 @@ package validationRules
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -36,7 +35,7 @@ func ExportAll(exportFilePath string, format string) {
 		return
 	}
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.VALIDATION_RULES, "", fmt.Sprintf("Error creating validation rules directory: %s", err))
 			utils.MarkResTypeFailure(utils.VALIDATION_RULES)
 			return
@@ -45,7 +44,7 @@ func ExportAll(exportFilePath string, format string) {
 
 	err := exportValidationRules(exportFilePath, format)
 	if err != nil {
-		utils.UpdateFailureSummary(utils.VALIDATION_RULES, resourceFileName)
+		utils.UpdateFailureSummary(utils.VALIDATION_RULES, resourceFileName, err)
 		utils.PrintLog(utils.LogLevelError, utils.VALIDATION_RULES, "", fmt.Sprintf("Error while exporting validation rules: %s", err))
 	} else {
 		utils.UpdateSuccessSummary(utils.VALIDATION_RULES, utils.EXPORT)
@@ -74,7 +73,7 @@ func exportValidationRules(outputDirPath string, formatString string) error {
 		return fmt.Errorf("error while serializing validation rules: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
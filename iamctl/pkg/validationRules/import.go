@@ -45,7 +45,7 @@ func ImportAll(inputDirPath string) {
 		err = importValidationRules(filePath)
 	}
 	if err != nil {
-		utils.UpdateFailureSummary(utils.VALIDATION_RULES, resourceFileName)
+		utils.UpdateFailureSummary(utils.VALIDATION_RULES, resourceFileName, err)
 		utils.PrintLog(utils.LogLevelError, utils.VALIDATION_RULES, "", fmt.Sprintf("Error importing validation rules: %s", err))
 	}
 }
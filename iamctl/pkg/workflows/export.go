@@ -20,7 +20,6 @@ package workflows
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -44,7 +43,7 @@ func ExportAll(exportFilePath string, format string) {
 	}
 
 	if _, err := os.Stat(exportFilePath); os.IsNotExist(err) {
-		if err := os.MkdirAll(exportFilePath, 0700); err != nil {
+		if err := utils.CreateExportDirectory(exportFilePath); err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.WORKFLOWS, "", fmt.Sprintf("Error creating workflows directory: %s", err))
 			utils.MarkResTypeFailure(utils.WORKFLOWS)
 			return
@@ -64,7 +63,7 @@ func ExportAll(exportFilePath string, format string) {
 			utils.PrintLog(utils.LogLevelInfo, utils.WORKFLOWS, wf.Name, "Exporting")
 			err := exportWorkflow(wf.ID, wf.Name, exportFilePath, format)
 			if err != nil {
-				utils.UpdateFailureSummary(utils.WORKFLOWS, wf.Name)
+				utils.UpdateFailureSummary(utils.WORKFLOWS, wf.Name, err)
 				utils.PrintLog(utils.LogLevelError, utils.WORKFLOWS, wf.Name, fmt.Sprintf("Error while exporting: %s", err))
 			} else {
 				if assocSharingSupported {
@@ -112,7 +111,7 @@ func exportWorkflow(workflowId string, workflowName string, outputDirPath string
 		return fmt.Errorf("error while serializing workflow: %w", err)
 	}
 
-	err = ioutil.WriteFile(exportedFileName, modifiedFile, 0644)
+	err = utils.WriteExportedFile(exportedFileName, modifiedFile)
 	if err != nil {
 		return fmt.Errorf("error when writing exported content to file: %w", err)
 	}
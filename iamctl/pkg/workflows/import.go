@@ -63,7 +63,7 @@ func ImportAll(inputDirPath string) {
 		existingAssoc, err = getWorkflowAssociationsList()
 		if err != nil {
 			utils.PrintLog(utils.LogLevelError, utils.WORKFLOWS, "", fmt.Sprintf("Error retrieving the deployed workflow association list: %s", err))
-			utils.UpdateFailureSummary(utils.WORKFLOWS, utils.WORKFLOW_ASSOCIATIONS.String())
+			utils.UpdateFailureSummary(utils.WORKFLOWS, utils.WORKFLOW_ASSOCIATIONS.String(), err)
 			return
 		}
 
@@ -71,7 +71,7 @@ func ImportAll(inputDirPath string) {
 			localAssoc, err := readLocalAssociationNames(importFilePath)
 			if err != nil {
 				utils.PrintLog(utils.LogLevelError, utils.WORKFLOWS, "", fmt.Sprintf("Error reading local workflow association list: %s", err))
-				utils.UpdateFailureSummary(utils.WORKFLOWS, utils.WORKFLOW_ASSOCIATIONS.String())
+				utils.UpdateFailureSummary(utils.WORKFLOWS, utils.WORKFLOW_ASSOCIATIONS.String(), err)
 				return
 			}
 			failedWorkflows, _ = removeDeletedDeployedWfAssociations(localAssoc, existingAssoc)
@@ -88,7 +88,7 @@ func ImportAll(inputDirPath string) {
 		}
 		if _, failed := failedWorkflows[workflowName]; failed {
 			utils.PrintLog(utils.LogLevelError, utils.WORKFLOWS, workflowName, "Skipping workflow: deleting stale workflow associations failed")
-			utils.UpdateFailureSummary(utils.WORKFLOWS, workflowName)
+			utils.UpdateFailureSummary(utils.WORKFLOWS, workflowName, nil)
 			continue
 		}
 
@@ -96,7 +96,9 @@ func ImportAll(inputDirPath string) {
 			workflowId := getWorkflowId(workflowName, existingWorkflows)
 			if err := importWorkflow(workflowName, workflowId, wfFilePath, existingAssoc); err != nil {
 				utils.PrintLog(utils.LogLevelError, utils.WORKFLOWS, workflowName, fmt.Sprintf("Error importing workflow: %s", err))
-				utils.UpdateFailureSummary(utils.WORKFLOWS, workflowName)
+				utils.UpdateFailureSummary(utils.WORKFLOWS, workflowName, err)
+			} else {
+				utils.MarkResourceCompleted(utils.WORKFLOWS, workflowName)
 			}
 		}
 	}
@@ -259,7 +261,7 @@ func removeDeletedDeployedWorkflows(localFiles []os.FileInfo, deployedWorkflows
 
 		utils.PrintLog(utils.LogLevelInfo, utils.WORKFLOWS, wf.Name, "Not found locally. Deleting workflow.")
 		if err := utils.SendDeleteRequest(wf.ID, utils.WORKFLOWS); err != nil {
-			utils.UpdateFailureSummary(utils.WORKFLOWS, wf.Name)
+			utils.UpdateFailureSummary(utils.WORKFLOWS, wf.Name, err)
 			utils.PrintLog(utils.LogLevelError, utils.WORKFLOWS, wf.Name, fmt.Sprintf("Error deleting workflow: %s", err))
 		} else {
 			utils.UpdateSuccessSummary(utils.WORKFLOWS, utils.DELETE)
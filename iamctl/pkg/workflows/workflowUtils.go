@@ -335,7 +335,7 @@ func writeWorkflowAssociationsList(outputDirPath string, formatString string) er
 		return fmt.Errorf("error serializing workflow associations list: %w", err)
 	}
 
-	if err := ioutil.WriteFile(exportedFileName, data, 0644); err != nil {
+	if err := utils.WriteExportedFile(exportedFileName, data); err != nil {
 		return fmt.Errorf("error writing workflow associations list: %w", err)
 	}
 	return nil
@@ -344,7 +344,7 @@ func writeWorkflowAssociationsList(outputDirPath string, formatString string) er
 func updateWorkflowExportSummary(success bool, successCount int) {
 
 	if !success {
-		utils.UpdateFailureSummary(utils.WORKFLOWS, utils.WORKFLOW_ASSOCIATIONS.String())
+		utils.UpdateFailureSummary(utils.WORKFLOWS, utils.WORKFLOW_ASSOCIATIONS.String(), nil)
 		return
 	}
 	for i := 0; i < successCount; i++ {
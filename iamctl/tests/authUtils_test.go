@@ -0,0 +1,331 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+// Mock token endpoint recording the token requests it receives.
+type mockAuthServer struct {
+	forms     []url.Values
+	basicAuth []bool
+}
+
+func (m *mockAuthServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	r.ParseForm()
+	m.forms = append(m.forms, r.PostForm)
+	_, _, hasBasicAuth := r.BasicAuth()
+	m.basicAuth = append(m.basicAuth, hasBasicAuth)
+	w.Write([]byte(`{"access_token": "issued-token", "expires_in": 3600}`))
+}
+
+func writePrivateKey(t *testing.T, dir string, key interface{}) string {
+
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("Error encoding the private key: %v", err)
+	}
+	keyPath := filepath.Join(dir, "private.key")
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes})
+	if err := ioutil.WriteFile(keyPath, keyPem, 0600); err != nil {
+		t.Fatalf("Error writing the private key: %v", err)
+	}
+	return keyPath
+}
+
+func verifyClientAssertion(t *testing.T, assertion string, publicKey crypto.PublicKey, expectedAlg string,
+	expectedAudience string) {
+
+	parts := strings.Split(assertion, ".")
+	if len(parts) != 3 {
+		t.Fatalf("Expected a JWT with 3 parts, got %d", len(parts))
+	}
+	var header, claims map[string]interface{}
+	for i, part := range []*map[string]interface{}{&header, &claims} {
+		data, err := base64.RawURLEncoding.DecodeString(parts[i])
+		if err != nil {
+			t.Fatalf("Error decoding the JWT: %v", err)
+		}
+		if err := json.Unmarshal(data, part); err != nil {
+			t.Fatalf("Error parsing the JWT: %v", err)
+		}
+	}
+	if header["alg"] != expectedAlg || header["kid"] != "key-1" {
+		t.Errorf("Unexpected JWT header: %v", header)
+	}
+	if claims["iss"] != "client-id" || claims["sub"] != "client-id" || claims["aud"] != expectedAudience ||
+		claims["jti"] == nil || claims["exp"] == nil {
+		t.Errorf("Unexpected JWT claims: %v", claims)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatalf("Error decoding the JWT signature: %v", err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			t.Errorf("Invalid JWT signature: %v", err)
+		}
+	case *ecdsa.PublicKey:
+		r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+		if len(signature) != 64 || !ecdsa.Verify(key, digest[:], r, s) {
+			t.Errorf("Invalid JWT signature")
+		}
+	}
+}
+
+func TestClientAuthentication(t *testing.T) {
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating the RSA key: %v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Error generating the EC key: %v", err)
+	}
+
+	tests := []struct {
+		description       string
+		authMethod        string
+		privateKey        interface{}
+		publicKey         crypto.PublicKey
+		expectedAlg       string
+		expectedBasicAuth bool
+	}{
+		{
+			description:       "Client secret is sent as basic authentication by default",
+			expectedBasicAuth: true,
+		},
+		{
+			description: "Client assertion is signed with a RSA key",
+			authMethod:  utils.CLIENT_AUTH_PRIVATE_KEY_JWT,
+			privateKey:  rsaKey,
+			publicKey:   &rsaKey.PublicKey,
+			expectedAlg: "RS256",
+		},
+		{
+			description: "Client assertion is signed with an EC key",
+			authMethod:  utils.CLIENT_AUTH_PRIVATE_KEY_JWT,
+			privateKey:  ecKey,
+			publicKey:   &ecKey.PublicKey,
+			expectedAlg: "ES256",
+		},
+		{
+			description: "Only the client id is sent for mTLS client authentication",
+			authMethod:  utils.CLIENT_AUTH_TLS_CLIENT_AUTH,
+		},
+	}
+
+	defer func() { utils.SERVER_CONFIGS = utils.ServerConfigs{} }()
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			mockServer := &mockAuthServer{}
+			server := httptest.NewServer(mockServer)
+			defer server.Close()
+
+			utils.SERVER_CONFIGS = utils.ServerConfigs{
+				ServerUrl:        server.URL,
+				TenantDomain:     "carbon.super",
+				ClientId:         "client-id",
+				ClientSecret:     "client-secret",
+				ClientAuthMethod: tc.authMethod,
+				PrivateKeyId:     "key-1",
+			}
+			if tc.privateKey != nil {
+				utils.SERVER_CONFIGS.PrivateKeyPath = writePrivateKey(t, t.TempDir(), tc.privateKey)
+			}
+			if tc.authMethod == utils.CLIENT_AUTH_TLS_CLIENT_AUTH {
+				utils.SERVER_CONFIGS.ClientCertPath = "client.crt"
+			}
+			if err := utils.InitAccessToken(nil); err != nil {
+				t.Fatalf("Unexpected error getting the access token: %v", err)
+			}
+
+			if len(mockServer.forms) != 1 {
+				t.Fatalf("Expected 1 token request, got %d", len(mockServer.forms))
+			}
+			form := mockServer.forms[0]
+			if mockServer.basicAuth[0] != tc.expectedBasicAuth {
+				t.Errorf("Expected basic authentication to be %v", tc.expectedBasicAuth)
+			}
+			if form.Get("grant_type") != "client_credentials" {
+				t.Errorf("Unexpected grant type: %s", form.Get("grant_type"))
+			}
+			if !tc.expectedBasicAuth && form.Get("client_id") != "client-id" {
+				t.Errorf("Expected the client id in the request, got %q", form.Get("client_id"))
+			}
+			if tc.authMethod == utils.CLIENT_AUTH_PRIVATE_KEY_JWT {
+				if form.Get("client_assertion_type") != utils.CLIENT_ASSERTION_TYPE_JWT {
+					t.Errorf("Unexpected client assertion type: %s", form.Get("client_assertion_type"))
+				}
+				verifyClientAssertion(t, form.Get("client_assertion"), tc.publicKey, tc.expectedAlg,
+					server.URL+"/t/carbon.super/oauth2/token")
+			} else if form.Get("client_assertion") != "" {
+				t.Errorf("Unexpected client assertion in the request")
+			}
+		})
+	}
+}
+
+func TestTokenExchangeGrant(t *testing.T) {
+
+	mockServer := &mockAuthServer{}
+	server := httptest.NewServer(mockServer)
+	defer server.Close()
+
+	subjectTokenFile := filepath.Join(t.TempDir(), "oidc-token")
+	if err := ioutil.WriteFile(subjectTokenFile, []byte("ci-oidc-token\n"), 0600); err != nil {
+		t.Fatalf("Error writing the subject token: %v", err)
+	}
+	defer func() { utils.SERVER_CONFIGS = utils.ServerConfigs{} }()
+	utils.SERVER_CONFIGS = utils.ServerConfigs{
+		ServerUrl:        server.URL,
+		TenantDomain:     "carbon.super",
+		ClientId:         "client-id",
+		ClientSecret:     "client-secret",
+		GrantType:        utils.GRANT_TYPE_TOKEN_EXCHANGE,
+		SubjectTokenFile: subjectTokenFile,
+	}
+	if err := utils.InitAccessToken(nil); err != nil {
+		t.Fatalf("Unexpected error getting the access token: %v", err)
+	}
+
+	form := mockServer.forms[0]
+	expected := map[string]string{
+		"grant_type":           utils.TOKEN_EXCHANGE_GRANT_TYPE,
+		"subject_token":        "ci-oidc-token",
+		"subject_token_type":   utils.TOKEN_TYPE_JWT,
+		"requested_token_type": utils.TOKEN_TYPE_ACCESS_TOKEN,
+	}
+	for param, value := range expected {
+		if form.Get(param) != value {
+			t.Errorf("Expected %s to be %q, got %q", param, value, form.Get(param))
+		}
+	}
+	if utils.SERVER_CONFIGS.Token != "issued-token" {
+		t.Errorf("Expected the exchanged token to be used, got %q", utils.SERVER_CONFIGS.Token)
+	}
+}
+
+func TestPreIssuedAccessToken(t *testing.T) {
+
+	var tokenRequests int
+	var lastAuthorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/oauth2/token") {
+			tokenRequests++
+			return
+		}
+		lastAuthorization = r.Header.Get("Authorization")
+		if lastAuthorization != "Bearer renewed-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := ioutil.WriteFile(tokenFile, []byte("expired-token"), 0600); err != nil {
+		t.Fatalf("Error writing the token file: %v", err)
+	}
+	defer func() { utils.SERVER_CONFIGS = utils.ServerConfigs{} }()
+	utils.SERVER_CONFIGS = utils.ServerConfigs{
+		ServerUrl:       server.URL,
+		TenantDomain:    "carbon.super",
+		Organization:    "sub-org",
+		AccessTokenFile: tokenFile,
+	}
+	if err := utils.InitAccessToken(nil); err != nil {
+		t.Fatalf("Unexpected error loading the access token: %v", err)
+	}
+	if utils.SERVER_CONFIGS.Token != "expired-token" {
+		t.Errorf("Expected the token from the file, got %q", utils.SERVER_CONFIGS.Token)
+	}
+
+	// The token file is read again when the server rejects the token.
+	if err := ioutil.WriteFile(tokenFile, []byte("renewed-token"), 0600); err != nil {
+		t.Fatalf("Error writing the token file: %v", err)
+	}
+	resp, err := utils.SendCustomRequest(http.MethodGet, server.URL+"/api/resource", nil, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || lastAuthorization != "Bearer renewed-token" {
+		t.Errorf("Expected the request to succeed with the renewed token, got %d", resp.StatusCode)
+	}
+	if tokenRequests != 0 {
+		t.Errorf("Expected no token requests, got %d", tokenRequests)
+	}
+}
+
+func TestInvalidAuthConfigs(t *testing.T) {
+
+	tests := []struct {
+		description string
+		configs     utils.ServerConfigs
+	}{
+		{
+			description: "Unsupported client authentication method",
+			configs:     utils.ServerConfigs{ClientAuthMethod: "client_secret_jwt"},
+		},
+		{
+			description: "Private key JWT without a private key",
+			configs:     utils.ServerConfigs{ClientAuthMethod: utils.CLIENT_AUTH_PRIVATE_KEY_JWT},
+		},
+		{
+			description: "Token exchange without a subject token",
+			configs:     utils.ServerConfigs{GrantType: utils.GRANT_TYPE_TOKEN_EXCHANGE},
+		},
+	}
+
+	defer func() { utils.SERVER_CONFIGS = utils.ServerConfigs{} }()
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			utils.SERVER_CONFIGS = tc.configs
+			utils.SERVER_CONFIGS.ServerUrl = "https://localhost:9443"
+			if err := utils.InitAccessToken(nil); err == nil {
+				t.Errorf("Expected an error for invalid auth configs")
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestProbeServerCapabilities(t *testing.T) {
+
+	// Mock 7.1 server without the workflow and notification sender v2 APIs, and with a failing actions API.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/oauth2/token"):
+			w.Write([]byte(`{"access_token": "token", "expires_in": 3600}`))
+		case strings.Contains(r.URL.Path, "/workflows"), strings.Contains(r.URL.Path, "/api/server/v2/"),
+			strings.Contains(r.URL.Path, "/script-libraries"):
+			w.WriteHeader(http.StatusNotFound)
+		case strings.Contains(r.URL.Path, "/actions"):
+			w.WriteHeader(http.StatusInternalServerError)
+		case strings.Contains(r.URL.Path, "/api-resources"):
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+
+	defer func() { utils.SERVER_CONFIGS = utils.ServerConfigs{} }()
+	defer utils.ResetProbedCapabilities()
+	utils.SERVER_CONFIGS = utils.ServerConfigs{ServerUrl: server.URL, TenantDomain: "carbon.super"}
+	if err := utils.InitAccessToken(nil); err != nil {
+		t.Fatalf("Unexpected error getting the access token: %v", err)
+	}
+
+	capabilities := utils.ProbeServerCapabilities()
+	expected := map[string]bool{
+		utils.CAPABILITY_ROLES_V2_API:               true,
+		utils.CAPABILITY_NOTIFICATION_TEMPLATES_API: true,
+		"ApiResources":                              true,
+		"SmsTemplates":                              true,
+		"Workflows":                                 false,
+		"EmailProviders":                            false,
+		"SmsProviders":                              false,
+		"ScriptLibraries":                           false,
+	}
+	if !reflect.DeepEqual(capabilities, expected) {
+		t.Errorf("Expected capabilities %v, got %v", expected, capabilities)
+	}
+
+	// The probed capabilities take precedence over the declared server version.
+	utils.SERVER_CONFIGS.ServerVersion = "7.2.0"
+	if utils.IsEntitySupportedInVersion(utils.WORKFLOWS) {
+		t.Errorf("Expected workflows to be unsupported as the API is not available")
+	}
+	utils.SERVER_CONFIGS.ServerVersion = "7.0.0"
+	if !utils.IsEntitySupportedInVersion(utils.SMS_TEMPLATES) {
+		t.Errorf("Expected SMS templates to be supported as the API is available")
+	}
+	// Actions are resolved from the server version, as the API could not be probed.
+	if utils.IsEntitySupportedInVersion(utils.ACTIONS) {
+		t.Errorf("Expected actions to be resolved from the server version 7.0.0")
+	}
+}
+
+func TestDeriveServerVersion(t *testing.T) {
+
+	tests := []struct {
+		description     string
+		capabilities    map[string]bool
+		expectedVersion string
+		expectError     bool
+	}{
+		{
+			description:     "Highest version of the available capabilities",
+			capabilities:    map[string]bool{utils.CAPABILITY_ROLES_V2_API: true, "Actions": true, "Workflows": false},
+			expectedVersion: "7.1.0",
+		},
+		{
+			description:     "Latest capabilities available",
+			capabilities:    map[string]bool{"ApiResources": true, "Workflows": true, "Actions": true},
+			expectedVersion: "7.2.0",
+		},
+		{
+			description:  "Servers older than 7.0.0 cannot be detected",
+			capabilities: map[string]bool{utils.CAPABILITY_ROLES_V2_API: false, "ScriptLibraries": true},
+			expectError:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			version, err := utils.DeriveServerVersion(tc.capabilities)
+			if tc.expectError {
+				if err == nil {
+					t.Errorf("Expected an error, got version %s", version)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if version != tc.expectedVersion {
+				t.Errorf("Expected version %s, got %s", tc.expectedVersion, version)
+			}
+		})
+	}
+}
+
+func TestGetVersionMismatches(t *testing.T) {
+
+	tests := []struct {
+		description        string
+		version            string
+		capabilities       map[string]bool
+		expectedMismatches int
+	}{
+		{
+			description:  "Capabilities match the declared version",
+			version:      "7.1.0",
+			capabilities: map[string]bool{"Actions": true, "Workflows": false, "ScriptLibraries": false},
+		},
+		{
+			description:        "Capability of a later version is available",
+			version:            "7.0.0",
+			capabilities:       map[string]bool{"Actions": true, "Workflows": true},
+			expectedMismatches: 2,
+		},
+		{
+			description:        "Capability of the declared version is not available",
+			version:            "6.1.0",
+			capabilities:       map[string]bool{"ScriptLibraries": false},
+			expectedMismatches: 1,
+		},
+		{
+			description:        "Latest version without a capability",
+			version:            "",
+			capabilities:       map[string]bool{"Workflows": false, "ScriptLibraries": false},
+			expectedMismatches: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			mismatches := utils.GetVersionMismatches(tc.version, tc.capabilities)
+			if len(mismatches) != tc.expectedMismatches {
+				t.Errorf("Expected %d mismatches, got %v", tc.expectedMismatches, mismatches)
+			}
+		})
+	}
+}
+
+func TestUndeclaredServerVersion(t *testing.T) {
+
+	// Mock server with all the probed APIs available, except the script libraries removed in later versions.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/oauth2/token"):
+			w.Write([]byte(`{"access_token": "token", "expires_in": 3600}`))
+		case strings.Contains(r.URL.Path, "/script-libraries"):
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+
+	defer func() { utils.SERVER_CONFIGS = utils.ServerConfigs{} }()
+	defer utils.ResetProbedCapabilities()
+	configDir := writeConfigFiles(t, `{"SERVER_URL": "`+server.URL+`", "CLIENT_ID": "id", "CLIENT_SECRET": "secret"}`, "{}", "{}")
+	if _, err := utils.LoadConfigs(configDir, utils.EXPORT); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The behaviour of the latest version is used, instead of the version derived from the capabilities.
+	if utils.SERVER_CONFIGS.ServerVersion != "" {
+		t.Errorf("Expected the server version to be left empty, got %s", utils.SERVER_CONFIGS.ServerVersion)
+	}
+	if !utils.IsEntitySupportedInVersion(utils.WORKFLOWS) {
+		t.Errorf("Expected workflows to be supported as the API is available")
+	}
+}
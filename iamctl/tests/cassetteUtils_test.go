@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+type cassetteTestRequest struct {
+	method      string
+	path        string
+	body        []byte
+	contentType string
+}
+
+func buildMultipartBody(t *testing.T, content string) ([]byte, string) {
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", "resource.yaml")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	part.Write([]byte(content))
+	writer.Close()
+	return body.Bytes(), writer.FormDataContentType()
+}
+
+func sendCassetteRequests(t *testing.T, baseUrl string, requests []cassetteTestRequest) []string {
+
+	var responses []string
+	for _, request := range requests {
+		resp, err := utils.SendCustomRequest(request.method, baseUrl+request.path, request.body, request.contentType)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s %s: %v", request.method, request.path, err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		responses = append(responses, resp.Status+" "+string(body))
+	}
+	return responses
+}
+
+func TestRecordAndReplay(t *testing.T) {
+
+	utils.InitHTTPClient(utils.DefaultHttpConfigs())
+	originalToken := utils.SERVER_CONFIGS.Token
+	utils.SERVER_CONFIGS.Token = "recorded-access-token"
+	defer func() { utils.SERVER_CONFIGS.Token = originalToken }()
+
+	var listCalls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		switch {
+		case r.URL.Path == "/applications" && r.Method == http.MethodGet:
+			listCalls++
+			w.Write([]byte(`{"count":` + strconv.Itoa(listCalls) + `}`))
+		case r.URL.Path == "/applications/import":
+			if !strings.Contains(string(body), "name: app1") {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+		case r.URL.Path == "/identity-providers":
+			w.Header().Set("Set-Cookie", "session=cookie-value")
+			w.Write([]byte(`{"name":"idp1","clientSecret":"response-secret"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	cassettePath := filepath.Join(t.TempDir(), "session.json")
+	if err := utils.StartRecording(cassettePath); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	multipartBody, multipartType := buildMultipartBody(t, "name: app1")
+	recordedResponses := sendCassetteRequests(t, server.URL, []cassetteTestRequest{
+		{http.MethodGet, "/applications?limit=10&offset=0", nil, ""},
+		{http.MethodGet, "/applications?limit=10&offset=0", nil, ""},
+		{http.MethodPost, "/applications/import", multipartBody, multipartType},
+		{http.MethodPost, "/identity-providers", []byte(`{"name":"idp1","clientSecret":"request-secret"}`), "application/json"},
+	})
+	if err := utils.StopCassette(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	server.Close()
+
+	data, err := ioutil.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var cassette utils.Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		t.Fatalf("Expected a valid cassette file, got error: %v", err)
+	}
+	if len(cassette.Interactions) != 4 {
+		t.Fatalf("Expected 4 recorded interactions, got %d", len(cassette.Interactions))
+	}
+	for _, secret := range []string{"recorded-access-token", "request-secret", "response-secret", "cookie-value"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("Expected %q to be scrubbed from the cassette", secret)
+		}
+	}
+
+	t.Run("Replay the recorded responses", func(t *testing.T) {
+		if err := utils.StartReplay(cassettePath); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		defer utils.StopCassette()
+
+		// The query parameters are reordered and the multipart body has a new boundary.
+		multipartBody, multipartType := buildMultipartBody(t, "name: app1")
+		replayedResponses := sendCassetteRequests(t, "https://offline.example.com", []cassetteTestRequest{
+			{http.MethodGet, "/applications?offset=0&limit=10", nil, ""},
+			{http.MethodGet, "/applications?limit=10&offset=0", nil, ""},
+			{http.MethodPost, "/applications/import", multipartBody, multipartType},
+			{http.MethodPost, "/identity-providers", []byte(`{"name": "idp1", "clientSecret": "other-secret"}`), "application/json"},
+		})
+		for i := range recordedResponses {
+			expected := recordedResponses[i]
+			if i == 3 {
+				expected = strings.Replace(expected, "response-secret", utils.REDACTED_VALUE, 1)
+			}
+			if replayedResponses[i] != expected {
+				t.Errorf("Expected replayed response %q, got %q", expected, replayedResponses[i])
+			}
+		}
+	})
+
+	t.Run("Unrecorded request", func(t *testing.T) {
+		if err := utils.StartReplay(cassettePath); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		defer utils.StopCassette()
+
+		multipartBody, multipartType := buildMultipartBody(t, "name: app2")
+		for _, request := range []cassetteTestRequest{
+			{http.MethodGet, "/claims", nil, ""},
+			{http.MethodDelete, "/applications?limit=10&offset=0", nil, ""},
+			{http.MethodPost, "/applications/import", multipartBody, multipartType},
+		} {
+			if _, err := utils.SendCustomRequest(request.method, "https://offline.example.com"+request.path,
+				request.body, request.contentType); err == nil {
+				t.Errorf("Expected an error for the unrecorded request %s %s", request.method, request.path)
+			}
+		}
+	})
+}
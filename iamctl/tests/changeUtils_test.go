@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestResolveChangedResources(t *testing.T) {
+
+	tests := []struct {
+		description  string
+		changedFiles []string
+		expected     map[utils.ResourceType][]string
+	}{
+		{
+			description:  "Resource files",
+			changedFiles: []string{"Applications/App1.yml", "IdentityProviders/Google.json", "Roles/Application%2Fmanager.yml"},
+			expected: map[utils.ResourceType][]string{
+				utils.APPLICATIONS:       {"App1"},
+				utils.IDENTITY_PROVIDERS: {"Google"},
+				utils.ROLES:              {"Application/manager"},
+			},
+		},
+		{
+			description:  "Files of sub directories mapped to the owning resource",
+			changedFiles: []string{"Applications/ApplicationAuthorizedApis/App2.yml", "EmailTemplates/Account Lock/en_US.yml", "Branding/CustomTexts/login/en-US.yml"},
+			expected: map[utils.ResourceType][]string{
+				utils.APPLICATIONS:    {"App2"},
+				utils.EMAIL_TEMPLATES: {"Account Lock"},
+				utils.CUSTOM_TEXTS:    {"login"},
+			},
+		},
+		{
+			description:  "Resource types not selectable by name changed as a whole",
+			changedFiles: []string{"ValidationRules/ValidationRules.yml", "Branding/BrandingPreferences/BrandingPreferences.yml"},
+			expected: map[utils.ResourceType][]string{
+				utils.VALIDATION_RULES:     nil,
+				utils.BRANDING_PREFERENCES: nil,
+			},
+		},
+		{
+			description:  "Files outside the resource type directories ignored",
+			changedFiles: []string{"configs/dev/serverConfig.json", "README.md", "Applications/notes.txt", "Unknown/file.yml"},
+			expected:     map[utils.ResourceType][]string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			selection := utils.ResolveChangedResources(tc.changedFiles)
+			if !reflect.DeepEqual(selection, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, selection)
+			}
+		})
+	}
+}
+
+func TestAddDependentResources(t *testing.T) {
+
+	inputDir := t.TempDir()
+	files := map[string]string{
+		"Workflows/approval.yml": "name: approval\ntemplate:\n  steps:\n  - step: 1\n    options:\n" +
+			"    - entity: roles\n      values:\n      - Application/manager\n",
+		"Roles/Application%2Fmanager.yml": "displayName: manager\naudience:\n  type: application\n  display: App1\n",
+		"Applications/App1.yml":           "applicationName: App1\noutboundProvisioningConfig:\n  provisioningIdentityProviders:\n  - identityProviderName: Google\n",
+		"Applications/App2.yml":           "applicationName: App2\n",
+		"IdentityProviders/Google.yml":    "name: Google\n",
+	}
+	for relativePath, content := range files {
+		if err := writeFile(filepath.Join(inputDir, filepath.FromSlash(relativePath)), content); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	tests := []struct {
+		description string
+		selection   map[utils.ResourceType][]string
+		expected    map[utils.ResourceType][]string
+	}{
+		{
+			description: "Transitive dependents",
+			selection:   map[utils.ResourceType][]string{utils.IDENTITY_PROVIDERS: {"Google"}},
+			expected: map[utils.ResourceType][]string{
+				utils.IDENTITY_PROVIDERS: {"Google"},
+				utils.APPLICATIONS:       {"App1"},
+				utils.ROLES:              {"Application/manager"},
+				utils.WORKFLOWS:          {"approval"},
+			},
+		},
+		{
+			description: "Resource without dependents",
+			selection:   map[utils.ResourceType][]string{utils.APPLICATIONS: {"App2"}},
+			expected:    map[utils.ResourceType][]string{utils.APPLICATIONS: {"App2"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			if err := utils.AddDependentResources(inputDir, tc.selection); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tc.selection, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, tc.selection)
+			}
+		})
+	}
+}
+
+func TestGetChangedFilesSince(t *testing.T) {
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+	repoDir := t.TempDir()
+	inputDir := filepath.Join(repoDir, "resources")
+	runGit := func(args ...string) {
+		command := exec.Command("git", append([]string{"-C", repoDir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		if output, err := command.CombinedOutput(); err != nil {
+			t.Fatalf("Error running git %v: %v: %s", args, err, output)
+		}
+	}
+
+	runGit("init", "-q")
+	for _, relativePath := range []string{"Applications/App1.yml", "Applications/App2.yml", "IdentityProviders/Google.yml"} {
+		if err := writeFile(filepath.Join(inputDir, filepath.FromSlash(relativePath)), "name: value\n"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if err := writeFile(filepath.Join(repoDir, "README.md"), "readme\n"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	runGit("add", "-A")
+	runGit("commit", "-q", "-m", "initial")
+
+	// A modified file, a renamed file, an untracked file and a file outside the input directory.
+	writeFile(filepath.Join(inputDir, "IdentityProviders", "Google.yml"), "name: changed\n")
+	runGit("mv", "resources/Applications/App2.yml", "resources/Applications/App3.yml")
+	runGit("commit", "-q", "-m", "rename")
+	writeFile(filepath.Join(inputDir, "Roles", "admin.yml"), "name: admin\n")
+	writeFile(filepath.Join(repoDir, "README.md"), "changed\n")
+	os.Remove(filepath.Join(inputDir, "Applications", "App1.yml"))
+
+	changedFiles, err := utils.GetChangedFilesSince(inputDir, "HEAD~1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sort.Strings(changedFiles)
+	expected := []string{"Applications/App1.yml", "Applications/App2.yml", "Applications/App3.yml",
+		"IdentityProviders/Google.yml", "Roles/admin.yml"}
+	if !reflect.DeepEqual(changedFiles, expected) {
+		t.Errorf("Expected %v, got %v", expected, changedFiles)
+	}
+
+	if _, err := utils.GetChangedFilesSince(inputDir, "unknown-ref"); err == nil {
+		t.Errorf("Expected an error for an unknown git reference")
+	}
+}
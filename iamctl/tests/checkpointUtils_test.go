@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestCheckpointResume(t *testing.T) {
+
+	checkpointDir, err := ioutil.TempDir("", "iamctl-checkpoint-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(checkpointDir)
+	checkpointFile := filepath.Join(checkpointDir, utils.CHECKPOINT_FILE_NAME)
+
+	defer func() {
+		utils.SERVER_CONFIGS = utils.ServerConfigs{}
+		utils.TOOL_CONFIGS = utils.ToolConfigs{}
+		utils.ResetResourceIdentifierMap()
+	}()
+	utils.SERVER_CONFIGS = utils.ServerConfigs{ServerUrl: "https://localhost:9443", TenantDomain: "carbon.super"}
+	utils.TOOL_CONFIGS = utils.ToolConfigs{}
+	utils.ResetResourceIdentifierMap()
+
+	// Record the progress of a failed import.
+	if _, err := utils.InitCheckpoint(checkpointFile, false); err != nil {
+		t.Fatalf("Unexpected error when starting the checkpoint: %v", err)
+	}
+	utils.MarkResourceTypeCompleted(utils.CLAIMS)
+	utils.AddToIdentifierMap(utils.APPLICATIONS, "app-1-id", "App1", utils.IMPORT)
+	utils.MarkResourceCompleted(utils.APPLICATIONS, "App1")
+
+	// Resume the import with a clean state.
+	utils.ResetResourceIdentifierMap()
+	utils.TOOL_CONFIGS = utils.ToolConfigs{}
+	completedTypes, err := utils.InitCheckpoint(checkpointFile, true)
+	if err != nil {
+		t.Fatalf("Unexpected error when resuming from the checkpoint: %v", err)
+	}
+	if !reflect.DeepEqual(completedTypes, []utils.ResourceType{utils.CLAIMS}) {
+		t.Errorf("Expected the completed resource types to be restored, got %v", completedTypes)
+	}
+	if id := utils.GetResourceIdentifierMap(utils.APPLICATIONS)["App1"]; id != "app-1-id" {
+		t.Errorf("Expected the identifier mapping of App1 to be restored, got %q", id)
+	}
+	if !utils.IsResourceExcluded("App1", utils.TOOL_CONFIGS.ApplicationConfigs) ||
+		utils.IsResourceExcluded("App2", utils.TOOL_CONFIGS.ApplicationConfigs) {
+		t.Errorf("Expected only the completed applications to be excluded")
+	}
+
+	// Resuming against another environment should fail.
+	utils.SERVER_CONFIGS.TenantDomain = "other.com"
+	if _, err := utils.InitCheckpoint(checkpointFile, true); err == nil {
+		t.Errorf("Expected an error when resuming against a different environment")
+	}
+
+	utils.RemoveCheckpoint()
+	if _, err := os.Stat(checkpointFile); !os.IsNotExist(err) {
+		t.Errorf("Expected the checkpoint file to be removed")
+	}
+}
+
+func TestExcludeResources(t *testing.T) {
+
+	testCases := []struct {
+		name          string
+		configs       map[string]interface{}
+		excluded      []string
+		expectedNames map[string]bool
+	}{
+		{"Without configs", nil, []string{"App1"}, map[string]bool{"App1": true, "App2": false}},
+		{"With exclude config", map[string]interface{}{utils.EXCLUDE_CONFIG: []interface{}{"App3"}}, []string{"App1"},
+			map[string]bool{"App1": true, "App2": false, "App3": true}},
+		{"With include only config", map[string]interface{}{utils.INCLUDE_ONLY_CONFIG: []interface{}{"App1", "App2"}},
+			[]string{"App1"}, map[string]bool{"App1": true, "App2": false, "App3": true}},
+	}
+
+	defer func() { utils.TOOL_CONFIGS = utils.ToolConfigs{} }()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			utils.TOOL_CONFIGS = utils.ToolConfigs{ApplicationConfigs: tc.configs}
+			if err := utils.ExcludeResources(utils.APPLICATIONS, tc.excluded); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			for name, expected := range tc.expectedNames {
+				if utils.IsResourceExcluded(name, utils.TOOL_CONFIGS.ApplicationConfigs) != expected {
+					t.Errorf("Expected excluded status of %s to be %v", name, expected)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestProcessResourceTypes(t *testing.T) {
+
+	tests := []struct {
+		description         string
+		concurrency         int
+		stopAfter           utils.ResourceType
+		expectedUnprocessed []utils.ResourceType
+	}{
+		{"Sequential", 1, "", nil},
+		{"Concurrent", 4, "", nil},
+		{"Sequential with stop", 1, utils.APPLICATIONS, []utils.ResourceType{utils.OIDC_SCOPES, utils.ROLES,
+			utils.CHALLENGE_QUESTIONS, utils.EMAIL_TEMPLATES, utils.SMS_TEMPLATES, utils.EMAIL_PROVIDERS, utils.SMS_PROVIDERS,
+			utils.SCRIPT_LIBRARIES, utils.GOVERNANCE_CONNECTORS, utils.CERTIFICATES, utils.WORKFLOWS, utils.VALIDATION_RULES,
+			utils.ACTIONS, utils.ORGANIZATIONS, utils.BRANDING, utils.FLOWS}},
+	}
+
+	defer utils.SetConcurrency(1)
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			if err := utils.SetConcurrency(tc.concurrency); err != nil {
+				t.Fatal(err)
+			}
+
+			var lock sync.Mutex
+			processed := make(map[utils.ResourceType]bool)
+			stopped := false
+			unprocessed := utils.ProcessResourceTypes(utils.ResourceOrder, func(resourceType utils.ResourceType) {
+				lock.Lock()
+				defer lock.Unlock()
+				for _, dependency := range utils.GetResourceTypeDependencies(resourceType) {
+					if !processed[dependency] {
+						t.Errorf("%s was processed before its dependency %s", resourceType, dependency)
+					}
+				}
+				processed[resourceType] = true
+				if resourceType == tc.stopAfter {
+					stopped = true
+				}
+			}, func() bool {
+				lock.Lock()
+				defer lock.Unlock()
+				return stopped
+			})
+
+			if !reflect.DeepEqual(unprocessed, tc.expectedUnprocessed) {
+				t.Errorf("Expected unprocessed resource types %v, got %v", tc.expectedUnprocessed, unprocessed)
+			}
+			if len(processed)+len(unprocessed) != len(utils.ResourceOrder) {
+				t.Errorf("Expected %d resource types to be handled, got %d", len(utils.ResourceOrder), len(processed)+len(unprocessed))
+			}
+		})
+	}
+}
+
+func TestProcessResources(t *testing.T) {
+
+	defer utils.SetConcurrency(1)
+	for _, concurrency := range []int{1, 3} {
+		if err := utils.SetConcurrency(concurrency); err != nil {
+			t.Fatal(err)
+		}
+
+		var lock sync.Mutex
+		processed := make([]bool, 20)
+		utils.ProcessResources(len(processed), func(index int) {
+			lock.Lock()
+			defer lock.Unlock()
+			processed[index] = true
+		})
+		for i, isProcessed := range processed {
+			if !isProcessed {
+				t.Errorf("Resource %d was not processed with concurrency %d", i, concurrency)
+			}
+		}
+	}
+}
+
+func TestSetConcurrency(t *testing.T) {
+
+	defer utils.SetConcurrency(1)
+	if err := utils.SetConcurrency(0); err == nil {
+		t.Errorf("Expected an error for a non-positive concurrency")
+	}
+	if err := utils.SetConcurrency(2); err != nil || utils.Concurrency != 2 {
+		t.Errorf("Expected the concurrency to be set to 2, got %d: %v", utils.Concurrency, err)
+	}
+}
+
+func TestConcurrentSummaryUpdates(t *testing.T) {
+
+	defer func() { utils.ResTypeSummaryMap = nil; utils.AggregatedSummary = utils.Summary{} }()
+	utils.ResTypeSummaryMap = nil
+	utils.AggregatedSummary = utils.Summary{}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			utils.UpdateSuccessSummary(utils.APPLICATIONS, utils.IMPORT)
+		}()
+		go func() {
+			defer wg.Done()
+			utils.UpdateFailureSummary(utils.ROLES, "role", nil)
+		}()
+	}
+	wg.Wait()
+
+	if summary, _ := utils.GetResTypeSummary(utils.APPLICATIONS); summary.SuccessfulImport != 50 {
+		t.Errorf("Expected 50 successful imports, got %d", summary.SuccessfulImport)
+	}
+	if summary, _ := utils.GetResTypeSummary(utils.ROLES); summary.FailedCount != 50 {
+		t.Errorf("Expected 50 failures, got %d", summary.FailedCount)
+	}
+	if utils.AggregatedSummary.TotalRequests != 100 {
+		t.Errorf("Expected 100 total requests, got %d", utils.AggregatedSummary.TotalRequests)
+	}
+}
@@ -0,0 +1,266 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestSortResourceTypes(t *testing.T) {
+
+	tests := []struct {
+		description   string
+		dependencies  []utils.ResourceTypeDependency
+		expectedOrder []utils.ResourceType
+		expectError   bool
+	}{
+		{
+			description: "Independent resource types keep the declared order",
+			dependencies: []utils.ResourceTypeDependency{
+				{ResourceType: utils.OIDC_SCOPES, DependsOn: nil},
+				{ResourceType: utils.CERTIFICATES, DependsOn: nil},
+			},
+			expectedOrder: []utils.ResourceType{utils.OIDC_SCOPES, utils.CERTIFICATES},
+		},
+		{
+			description: "Dependencies are processed first",
+			dependencies: []utils.ResourceTypeDependency{
+				{ResourceType: utils.ROLES, DependsOn: []utils.ResourceType{utils.APPLICATIONS}},
+				{ResourceType: utils.APPLICATIONS, DependsOn: []utils.ResourceType{utils.IDENTITY_PROVIDERS}},
+				{ResourceType: utils.OIDC_SCOPES, DependsOn: nil},
+				{ResourceType: utils.IDENTITY_PROVIDERS, DependsOn: nil},
+			},
+			expectedOrder: []utils.ResourceType{utils.OIDC_SCOPES, utils.IDENTITY_PROVIDERS, utils.APPLICATIONS, utils.ROLES},
+		},
+		{
+			description: "Dependency cycle",
+			dependencies: []utils.ResourceTypeDependency{
+				{ResourceType: utils.ROLES, DependsOn: []utils.ResourceType{utils.WORKFLOWS}},
+				{ResourceType: utils.WORKFLOWS, DependsOn: []utils.ResourceType{utils.ROLES}},
+				{ResourceType: utils.OIDC_SCOPES, DependsOn: nil},
+			},
+			expectError: true,
+		},
+		{
+			description: "Indirect dependency cycle",
+			dependencies: []utils.ResourceTypeDependency{
+				{ResourceType: utils.APPLICATIONS, DependsOn: []utils.ResourceType{utils.ROLES}},
+				{ResourceType: utils.ROLES, DependsOn: []utils.ResourceType{utils.WORKFLOWS}},
+				{ResourceType: utils.WORKFLOWS, DependsOn: []utils.ResourceType{utils.APPLICATIONS}},
+			},
+			expectError: true,
+		},
+		{
+			description: "Undeclared dependency",
+			dependencies: []utils.ResourceTypeDependency{
+				{ResourceType: utils.ROLES, DependsOn: []utils.ResourceType{utils.APPLICATIONS}},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			order, err := utils.SortResourceTypes(tc.dependencies)
+			if (err != nil) != tc.expectError {
+				t.Fatalf("Unexpected error result: %v", err)
+			}
+			if !reflect.DeepEqual(order, tc.expectedOrder) {
+				t.Errorf("Expected order %v, got %v", tc.expectedOrder, order)
+			}
+		})
+	}
+}
+
+func TestResourceOrder(t *testing.T) {
+
+	expected := []utils.ResourceType{
+		utils.USERSTORES,
+		utils.CLAIMS,
+		utils.IDENTITY_PROVIDERS,
+		utils.API_RESOURCES,
+		utils.APPLICATIONS,
+		utils.OIDC_SCOPES,
+		utils.ROLES,
+		utils.CHALLENGE_QUESTIONS,
+		utils.EMAIL_TEMPLATES,
+		utils.SMS_TEMPLATES,
+		utils.EMAIL_PROVIDERS,
+		utils.SMS_PROVIDERS,
+		utils.SCRIPT_LIBRARIES,
+		utils.GOVERNANCE_CONNECTORS,
+		utils.CERTIFICATES,
+		utils.WORKFLOWS,
+		utils.VALIDATION_RULES,
+		utils.ACTIONS,
+		utils.ORGANIZATIONS,
+		utils.BRANDING,
+		utils.FLOWS,
+	}
+	order, err := utils.SortResourceTypes(utils.RESOURCE_TYPE_DEPENDENCIES)
+	if err != nil {
+		t.Fatalf("Invalid resource type dependencies: %v", err)
+	}
+	if !reflect.DeepEqual(order, expected) || !reflect.DeepEqual(utils.ResourceOrder, expected) {
+		t.Errorf("Expected order %v, got %v", expected, order)
+	}
+}
+
+func TestResourceOrderRespectsDependencies(t *testing.T) {
+
+	positions := make(map[utils.ResourceType]int)
+	for i, resourceType := range utils.ResourceOrder {
+		positions[resourceType] = i
+	}
+	if len(positions) != len(utils.RESOURCE_TYPE_DEPENDENCIES) {
+		t.Fatalf("Expected %d resource types, got %d", len(utils.RESOURCE_TYPE_DEPENDENCIES), len(positions))
+	}
+	for _, dependency := range utils.RESOURCE_TYPE_DEPENDENCIES {
+		for _, dependsOn := range dependency.DependsOn {
+			if positions[dependsOn] > positions[dependency.ResourceType] {
+				t.Errorf("Expected %s to be processed before %s", dependsOn, dependency.ResourceType)
+			}
+		}
+	}
+}
+
+func TestGetDependentResourceTypes(t *testing.T) {
+
+	expected := []utils.ResourceType{utils.ROLES, utils.GOVERNANCE_CONNECTORS, utils.WORKFLOWS, utils.ACTIONS, utils.FLOWS}
+	dependents := utils.GetDependentResourceTypes(utils.APPLICATIONS)
+	if !reflect.DeepEqual(dependents, expected) {
+		t.Errorf("Expected %v, got %v", expected, dependents)
+	}
+}
+
+func TestResolveResourceDependencies(t *testing.T) {
+
+	inputDir, err := ioutil.TempDir("", "iamctl-dependencies-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(inputDir)
+
+	files := map[string]string{
+		"Workflows/approval.yml": "name: approval\ntemplate:\n  steps:\n  - step: 1\n    options:\n" +
+			"    - entity: roles\n      values:\n      - Application/manager\n      - missing-role\n",
+		"Roles/Application%2Fmanager.yml":                 "displayName: manager\naudience:\n  type: application\n  display: App1\n",
+		"Applications/App1.yml":                           "applicationName: App1\noutboundProvisioningConfig:\n  provisioningIdentityProviders:\n  - identityProviderName: Google\n",
+		"Applications/ApplicationAuthorizedApis/App1.yml": "- identifier: orders_api\n  policyIdentifier: RBAC\n",
+		"IdentityProviders/Google.yml":                    "name: Google\n",
+		"ApiResources/orders_api.yml":                     "identifier: orders_api\n",
+		"ApiResources/unused_api.yml":                     "identifier: unused_api\n",
+	}
+	for relativePath, content := range files {
+		filePath := filepath.Join(inputDir, filepath.FromSlash(relativePath))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		description  string
+		resourceType utils.ResourceType
+		resourceName string
+		expected     map[utils.ResourceType][]string
+	}{
+		{
+			description:  "Transitive dependencies",
+			resourceType: utils.WORKFLOWS,
+			resourceName: "approval",
+			expected: map[utils.ResourceType][]string{
+				utils.WORKFLOWS:          {"approval"},
+				utils.ROLES:              {"Application/manager"},
+				utils.APPLICATIONS:       {"App1"},
+				utils.IDENTITY_PROVIDERS: {"Google"},
+				utils.API_RESOURCES:      {"orders_api"},
+			},
+		},
+		{
+			description:  "Resource without dependencies",
+			resourceType: utils.IDENTITY_PROVIDERS,
+			resourceName: "Google",
+			expected:     map[utils.ResourceType][]string{utils.IDENTITY_PROVIDERS: {"Google"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			selection, err := utils.ResolveResourceDependencies(inputDir, tc.resourceType, tc.resourceName)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			for _, names := range selection {
+				sort.Strings(names)
+			}
+			if !reflect.DeepEqual(selection, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, selection)
+			}
+		})
+	}
+}
+
+func TestRenderDependencyGraph(t *testing.T) {
+
+	graph := utils.ResourceDependencyGraph{
+		ResourceTypes: []utils.ResourceTypeDependency{
+			{ResourceType: utils.APPLICATIONS, DependsOn: nil},
+			{ResourceType: utils.ROLES, DependsOn: []utils.ResourceType{utils.APPLICATIONS}},
+		},
+		ResourceDependencies: map[utils.ResourceKey][]utils.ResourceKey{
+			{ResourceType: utils.ROLES, Name: "manager"}: {{ResourceType: utils.APPLICATIONS, Name: "App1"}},
+		},
+	}
+
+	tests := []struct {
+		format       string
+		expectedSubs []string
+		expectError  bool
+	}{
+		{utils.GRAPH_FORMAT_DOT, []string{"digraph iamctl {", `n0 [label="Applications"];`, "n1 -> n0;",
+			`n3 [label="Applications: App1"];`, "n2 -> n3;"}, false},
+		{utils.GRAPH_FORMAT_MERMAID, []string{"graph TD", `n0["Applications"]`, "n1 --> n0", "n2 --> n3"}, false},
+		{"svg", nil, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.format, func(t *testing.T) {
+			output, err := utils.RenderDependencyGraph(graph, tc.format)
+			if (err != nil) != tc.expectError {
+				t.Fatalf("Unexpected error result: %v", err)
+			}
+			for _, sub := range tc.expectedSubs {
+				if !strings.Contains(output, sub) {
+					t.Errorf("Expected output to contain %q, got:\n%s", sub, output)
+				}
+			}
+		})
+	}
+}
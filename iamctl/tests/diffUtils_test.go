@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestCompareResourceData(t *testing.T) {
+
+	tests := []struct {
+		description   string
+		localData     interface{}
+		deployedData  interface{}
+		expectedPaths []string
+	}{
+		{
+			description:   "Identical data",
+			localData:     map[string]interface{}{"name": "lib1", "description": "sample"},
+			deployedData:  map[string]interface{}{"name": "lib1", "description": "sample"},
+			expectedPaths: nil,
+		},
+		{
+			description:   "Changed and added fields",
+			localData:     map[string]interface{}{"name": "lib1", "description": "new", "enabled": true},
+			deployedData:  map[string]interface{}{"name": "lib1", "description": "old"},
+			expectedPaths: []string{"description", "enabled"},
+		},
+		{
+			description:   "Nested fields",
+			localData:     map[string]interface{}{"config": map[string]interface{}{"timeout": 10, "retries": 3}},
+			deployedData:  map[string]interface{}{"config": map[string]interface{}{"timeout": 20, "retries": 3}},
+			expectedPaths: []string{"config.timeout"},
+		},
+		{
+			description:   "Masked secrets are ignored",
+			localData:     map[string]interface{}{"secret": "actual-secret"},
+			deployedData:  map[string]interface{}{"secret": "********"},
+			expectedPaths: nil,
+		},
+		{
+			description:   "Empty values are treated as missing",
+			localData:     map[string]interface{}{"description": "", "tags": []interface{}{}},
+			deployedData:  map[string]interface{}{},
+			expectedPaths: nil,
+		},
+		{
+			description: "Array elements are matched by identifier",
+			localData: map[string]interface{}{"properties": []interface{}{
+				map[string]interface{}{"name": "b", "value": "2"},
+				map[string]interface{}{"name": "a", "value": "1"},
+			}},
+			deployedData: map[string]interface{}{"properties": []interface{}{
+				map[string]interface{}{"name": "a", "value": "1"},
+				map[string]interface{}{"name": "b", "value": "3"},
+			}},
+			expectedPaths: []string{"properties.[name=b].value"},
+		},
+		{
+			description:   "Scalar arrays are compared as a whole",
+			localData:     map[string]interface{}{"scopes": []interface{}{"openid", "email"}},
+			deployedData:  map[string]interface{}{"scopes": []interface{}{"openid"}},
+			expectedPaths: []string{"scopes"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			diffs := utils.CompareResourceData(tc.localData, tc.deployedData, utils.SCRIPT_LIBRARIES)
+
+			var paths []string
+			for _, diff := range diffs {
+				paths = append(paths, diff.Path)
+			}
+			if !reflect.DeepEqual(paths, tc.expectedPaths) {
+				t.Errorf("Unexpected result for %s: expected %v, but got %v", tc.description, tc.expectedPaths, paths)
+			}
+		})
+	}
+}
+
+func TestResolveResourceFromPath(t *testing.T) {
+
+	tests := []struct {
+		description  string
+		resourceType utils.ResourceType
+		relativePath string
+		expectedType utils.ResourceType
+		expectedName string
+	}{
+		{"Resource file", utils.APPLICATIONS, "App1", utils.APPLICATIONS, "App1"},
+		{"Authorized APIs file", utils.APPLICATIONS, "ApplicationAuthorizedApis/App1", utils.APPLICATIONS, "App1"},
+		{"Template file", utils.EMAIL_TEMPLATES, "AccountConfirmation/en_US", utils.EMAIL_TEMPLATES, "AccountConfirmation"},
+		{"Branding preference file", utils.BRANDING, "BrandingPreferences/ORG", utils.BRANDING_PREFERENCES, "ORG"},
+		{"Custom text file", utils.BRANDING, "CustomTexts/login/en-US", utils.CUSTOM_TEXTS, "login"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			resourceType, resourceName := utils.ResolveResourceFromPath(tc.resourceType, tc.relativePath)
+
+			if resourceType != tc.expectedType || resourceName != tc.expectedName {
+				t.Errorf("Unexpected result for %s: expected %s/%s, but got %s/%s", tc.description,
+					tc.expectedType, tc.expectedName, resourceType, resourceName)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestDetectResourceDrift(t *testing.T) {
+
+	baseDir, err := ioutil.TempDir("", "iamctl-drift-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(baseDir)
+
+	utils.KEYWORD_CONFIGS = utils.KeywordConfigs{KeywordMappings: map[string]interface{}{"CALLBACK_HOST": "prod.example.com"}}
+	defer func() { utils.KEYWORD_CONFIGS = utils.KeywordConfigs{} }()
+
+	files := map[string]string{
+		"local/Unchanged.yml":     "name: Unchanged\nsecret: '********'\ncallback: https://{{CALLBACK_HOST}}/cb\n",
+		"deployed/Unchanged.yml":  "name: Unchanged\nsecret: '********'\ncallback: https://prod.example.com/cb\n",
+		"local/Changed.yml":       "name: Changed\ndescription: local\n",
+		"deployed/Changed.yml":    "name: Changed\ndescription: deployed\n",
+		"local/LocalOnly.yml":     "name: LocalOnly\n",
+		"deployed/ServerOnly.yml": "name: ServerOnly\n",
+	}
+	for relativePath, content := range files {
+		filePath := filepath.Join(baseDir, filepath.FromSlash(relativePath))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	drift, err := utils.DetectResourceDrift(utils.OIDC_SCOPES, filepath.Join(baseDir, "local"), filepath.Join(baseDir, "deployed"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		description string
+		resources   []utils.ResourceDrift
+		expected    string
+	}{
+		{"Resources only in the deployed environment are added", drift.Added, "ServerOnly"},
+		{"Resources only in the local directory are removed", drift.Removed, "LocalOnly"},
+		{"Resources with different fields are changed", drift.Changed, "Changed"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			if len(tc.resources) != 1 || tc.resources[0].ResourceName != tc.expected {
+				t.Errorf("Expected only %s, got %v", tc.expected, tc.resources)
+			}
+		})
+	}
+	if len(drift.Changed) == 1 && (len(drift.Changed[0].FieldDiffs) != 1 || drift.Changed[0].FieldDiffs[0].Path != "description") {
+		t.Errorf("Expected only the description to be changed, got %v", drift.Changed[0].FieldDiffs)
+	}
+
+	report := utils.NewDriftReport()
+	report.AddResourceTypeDrift(utils.ResourceTypeDrift{ResourceType: utils.CLAIMS})
+	if report.Drifted {
+		t.Error("Expected no drift for a resource type without differences")
+	}
+	report.AddResourceTypeDrift(drift)
+	if !report.Drifted {
+		t.Error("Expected drift to be reported")
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestDryRunFileOperations(t *testing.T) {
+
+	tempDir, err := ioutil.TempDir("", "iamctl-dry-run-")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %s", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	existingFile := filepath.Join(tempDir, "existing.yml")
+	if err := ioutil.WriteFile(existingFile, []byte("name: existing"), 0644); err != nil {
+		t.Fatalf("Error creating file: %s", err)
+	}
+
+	utils.DryRun = true
+	defer func() { utils.DryRun = false }()
+
+	tests := []struct {
+		description string
+		operation   func() error
+		path        string
+		shouldExist bool
+	}{
+		{
+			description: "Write file",
+			operation:   func() error { return utils.WriteExportedFile(filepath.Join(tempDir, "new.yml"), []byte("name: new")) },
+			path:        filepath.Join(tempDir, "new.yml"),
+			shouldExist: false,
+		},
+		{
+			description: "Create directory",
+			operation:   func() error { return utils.CreateExportDirectory(filepath.Join(tempDir, "newDir")) },
+			path:        filepath.Join(tempDir, "newDir"),
+			shouldExist: false,
+		},
+		{
+			description: "Remove file",
+			operation:   func() error { return utils.RemoveLocalPath(existingFile) },
+			path:        existingFile,
+			shouldExist: true,
+		},
+		{
+			description: "Remove directory",
+			operation:   func() error { return utils.RemoveLocalDirectory(tempDir) },
+			path:        tempDir,
+			shouldExist: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			if err := tc.operation(); err != nil {
+				t.Errorf("Unexpected error for %s: %s", tc.description, err)
+			}
+			_, err := os.Stat(tc.path)
+			if exists := err == nil; exists != tc.shouldExist {
+				t.Errorf("Unexpected result for %s: expected path to exist: %v, but got %v", tc.description, tc.shouldExist, exists)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestServerErrorResponse(t *testing.T) {
+
+	tests := []struct {
+		description      string
+		responseBody     string
+		expectedResponse *utils.ErrorResponse
+		expectedMessage  string
+	}{
+		{
+			description: "Error response of the server is attached to the error",
+			responseBody: `{"code": "OSM-60001", "message": "Invalid request.", ` +
+				`"description": "Scope name is invalid.", "traceId": "a1b2c3"}`,
+			expectedResponse: &utils.ErrorResponse{Code: "OSM-60001", Message: "Invalid request.",
+				Description: "Scope name is invalid.", TraceID: "a1b2c3"},
+			expectedMessage: "error response for the delete request: " + utils.ErrorCodes[400] +
+				" [OSM-60001] Scope name is invalid. (trace ID: a1b2c3)",
+		},
+		{
+			description:     "Response without an error payload",
+			responseBody:    "Bad request",
+			expectedMessage: "error response for the delete request: " + utils.ErrorCodes[400],
+		},
+	}
+
+	defer func() { utils.SERVER_CONFIGS = utils.ServerConfigs{} }()
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if strings.HasSuffix(r.URL.Path, "/oauth2/token") {
+					w.Write([]byte(`{"access_token": "token", "expires_in": 3600}`))
+					return
+				}
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(tc.responseBody))
+			}))
+			defer server.Close()
+
+			utils.SERVER_CONFIGS = utils.ServerConfigs{ServerUrl: server.URL, TenantDomain: "carbon.super"}
+			if err := utils.InitAccessToken(nil); err != nil {
+				t.Fatalf("Unexpected error getting the access token: %v", err)
+			}
+
+			err := utils.SendDeleteRequest("scope", utils.OIDC_SCOPES)
+			if err == nil {
+				t.Fatalf("Expected an error for the rejected request")
+			}
+			if err.Error() != tc.expectedMessage {
+				t.Errorf("Expected error message %q, got %q", tc.expectedMessage, err.Error())
+			}
+			// The error response is available through wrapped errors as well.
+			errorResponse := utils.GetErrorResponse(fmt.Errorf("error deleting scope: %w", err))
+			if tc.expectedResponse == nil {
+				if errorResponse != nil {
+					t.Errorf("Expected no error response, got %+v", errorResponse)
+				}
+			} else if !reflect.DeepEqual(errorResponse, tc.expectedResponse) {
+				t.Errorf("Expected error response %+v, got %+v", tc.expectedResponse, errorResponse)
+			}
+		})
+	}
+}
+
+func TestFailureSummaryWithServerError(t *testing.T) {
+
+	defer utils.ResetSummary()
+	utils.ResetSummary()
+
+	serverErr := utils.NewServerError(http.StatusConflict,
+		[]byte(`{"code": "APP-60007", "description": "Application already exists.", "traceId": "t-123"}`),
+		"error response for the import request: "+utils.ErrorCodes[409])
+	utils.UpdateFailureSummary(utils.APPLICATIONS, "Pickup App", fmt.Errorf("error importing application: %w", serverErr))
+	utils.UpdateFailureSummary(utils.APPLICATIONS, "Other App", nil)
+
+	report := utils.BuildSummaryReport(utils.IMPORT)
+	failures := report.ResourceTypes[0].Failures
+	if len(failures) != 2 {
+		t.Fatalf("Expected 2 failures, got %v", failures)
+	}
+	if failures[0].Code != "APP-60007" || failures[0].Description != "Application already exists." ||
+		failures[0].TraceID != "t-123" {
+		t.Errorf("Expected the server error details in the failure, got %+v", failures[0])
+	}
+	if failures[1].ResourceName != "Other App" || failures[1].Code != "" {
+		t.Errorf("Expected a failure without server error details, got %+v", failures[1])
+	}
+
+	data, err := utils.FormatSummaryReport(report, utils.SUMMARY_FORMAT_JSON)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, sub := range []string{`"code": "APP-60007"`, `"traceId": "t-123"`} {
+		if !strings.Contains(string(data), sub) {
+			t.Errorf("Expected the report to contain %q, got:\n%s", sub, data)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestRequestRetries(t *testing.T) {
+
+	configs := utils.DefaultHttpConfigs()
+	configs.MaxRetries = 2
+	configs.RetryBaseDelayMs = 1
+	configs.RetryMaxDelayMs = 10
+	utils.InitHTTPClient(configs)
+	defer utils.InitHTTPClient(utils.DefaultHttpConfigs())
+
+	tests := []struct {
+		description      string
+		method           string
+		failureStatus    int
+		failures         int32
+		retryAfter       string
+		expectedStatus   int
+		expectedAttempts int32
+	}{
+		{"Idempotent request retried after a bad gateway", http.MethodGet, http.StatusBadGateway, 1, "", http.StatusOK, 2},
+		{"Non idempotent request not retried after a bad gateway", http.MethodPost, http.StatusBadGateway, 1, "", http.StatusBadGateway, 1},
+		{"Non idempotent request retried after too many requests", http.MethodPost, http.StatusTooManyRequests, 2, "0", http.StatusOK, 3},
+		{"Retries exhausted", http.MethodPut, http.StatusServiceUnavailable, 5, "", http.StatusServiceUnavailable, 3},
+		{"Client errors not retried", http.MethodGet, http.StatusNotFound, 1, "", http.StatusNotFound, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				if r.Method == http.MethodPost && string(body) != "payload" {
+					t.Errorf("Expected the request body to be sent on each attempt, got %q", body)
+				}
+				if atomic.AddInt32(&attempts, 1) <= tc.failures {
+					if tc.retryAfter != "" {
+						w.Header().Set("Retry-After", tc.retryAfter)
+					}
+					w.WriteHeader(tc.failureStatus)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			var body []byte
+			if tc.method == http.MethodPost {
+				body = []byte("payload")
+			}
+			resp, err := utils.SendCustomRequest(tc.method, server.URL, body, "text/plain")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tc.expectedStatus {
+				t.Errorf("Expected status %d, got %d", tc.expectedStatus, resp.StatusCode)
+			}
+			if attempts != tc.expectedAttempts {
+				t.Errorf("Expected %d attempts, got %d", tc.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestImportRequestRetriesWithPayloadLogging(t *testing.T) {
+
+	configs := utils.DefaultHttpConfigs()
+	configs.MaxRetries = 2
+	configs.RetryBaseDelayMs = 1
+	configs.RetryMaxDelayMs = 10
+	utils.InitHTTPClient(configs)
+	defer utils.InitHTTPClient(utils.DefaultHttpConfigs())
+
+	originalServerConfigs, originalToolConfigs := utils.SERVER_CONFIGS, utils.TOOL_CONFIGS
+	defer func() { utils.SERVER_CONFIGS, utils.TOOL_CONFIGS = originalServerConfigs, originalToolConfigs }()
+	utils.TOOL_CONFIGS.Logs.LogRequestPayloads = true
+
+	tests := []struct {
+		description   string
+		failureStatus int
+	}{
+		{"Retried after too many requests", http.StatusTooManyRequests},
+		{"Retried with a new access token", http.StatusUnauthorized},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if strings.HasSuffix(r.URL.Path, "/oauth2/token") {
+					w.Write([]byte(`{"access_token": "token", "expires_in": 3600}`))
+					return
+				}
+				body, _ := ioutil.ReadAll(r.Body)
+				if !strings.Contains(string(body), "applicationName: App1") {
+					t.Errorf("Expected the request body to be sent on each attempt, got %q", body)
+				}
+				if atomic.AddInt32(&attempts, 1) == 1 {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(tc.failureStatus)
+					return
+				}
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer server.Close()
+
+			utils.SERVER_CONFIGS = utils.ServerConfigs{ServerUrl: server.URL, TenantDomain: "carbon.super"}
+			if err := utils.InitAccessToken(nil); err != nil {
+				t.Fatalf("Unexpected error getting the access token: %v", err)
+			}
+			resp, err := utils.SendImportRequest("App1.yml", "applicationName: App1\n", utils.APPLICATIONS)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			resp.Body.Close()
+			if attempts != 2 {
+				t.Errorf("Expected 2 attempts, got %d", attempts)
+			}
+		})
+	}
+}
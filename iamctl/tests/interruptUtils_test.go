@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"runtime"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestProcessResourcesAfterInterrupt(t *testing.T) {
+
+	tests := []struct {
+		description string
+		concurrency int
+	}{
+		{"Sequential", 1},
+		{"Concurrent", 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			utils.ResetInterrupt()
+			defer utils.ResetInterrupt()
+			if err := utils.SetConcurrency(tc.concurrency); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			defer utils.SetConcurrency(1)
+
+			var processed int32
+			utils.ProcessResources(10, func(index int) {
+				atomic.AddInt32(&processed, 1)
+				if index == 1 {
+					utils.Interrupt()
+				}
+			})
+			// The resources being processed when interrupted are completed, and no new ones are started.
+			if processed < 2 || int(processed) > 1+tc.concurrency {
+				t.Errorf("Expected the processing to stop after the interrupt, processed %d resources", processed)
+			}
+		})
+	}
+}
+
+func TestRequestsAfterInterrupt(t *testing.T) {
+
+	configs := utils.DefaultHttpConfigs()
+	configs.MaxRetries = 3
+	configs.RetryMaxDelayMs = 30000
+	utils.InitHTTPClient(configs)
+	defer utils.InitHTTPClient(utils.DefaultHttpConfigs())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/unavailable" {
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	t.Run("Requests of the current resource are sent after the first interrupt", func(t *testing.T) {
+		utils.ResetInterrupt()
+		defer utils.ResetInterrupt()
+
+		utils.Interrupt()
+		resp, err := utils.SendCustomRequest(http.MethodGet, server.URL, nil, "")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		resp.Body.Close()
+		if utils.GetExitCode() != utils.EXIT_CODE_INTERRUPTED {
+			t.Errorf("Expected exit code %d, got %d", utils.EXIT_CODE_INTERRUPTED, utils.GetExitCode())
+		}
+	})
+
+	t.Run("Requests are cancelled after the second interrupt", func(t *testing.T) {
+		utils.ResetInterrupt()
+		defer utils.ResetInterrupt()
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			utils.CancelRequests()
+		}()
+		start := time.Now()
+		_, err := utils.SendCustomRequest(http.MethodGet, server.URL+"/unavailable", nil, "")
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected the request to be cancelled, got %v", err)
+		}
+		if time.Since(start) > 5*time.Second {
+			t.Errorf("Expected the retry delay to be cancelled, took %s", time.Since(start))
+		}
+	})
+}
+
+func TestInterruptedSummary(t *testing.T) {
+
+	utils.ResetInterrupt()
+	defer utils.ResetInterrupt()
+	utils.ResetSummary()
+	defer utils.ResetSummary()
+
+	utils.UpdateSuccessSummary(utils.APPLICATIONS, utils.IMPORT)
+	utils.MarkResTypeInterrupted(utils.APPLICATIONS)
+	utils.UpdateSkipSummary(utils.ROLES, "Not processed as the run was interrupted")
+	utils.Interrupt()
+
+	report := utils.BuildSummaryReport(utils.IMPORT)
+	if !report.Interrupted {
+		t.Errorf("Expected the report to be marked as interrupted")
+	}
+	for _, resourceType := range report.ResourceTypes {
+		switch resourceType.ResourceType {
+		case utils.APPLICATIONS:
+			if !resourceType.Interrupted || resourceType.SuccessfulImports != 1 {
+				t.Errorf("Expected the applications to be interrupted after one import, got %+v", resourceType)
+			}
+		case utils.ROLES:
+			if resourceType.Status != utils.RESOURCE_TYPE_STATUS_SKIPPED || resourceType.Interrupted {
+				t.Errorf("Expected the roles to be skipped, got %+v", resourceType)
+			}
+		}
+	}
+}
+
+func TestHandleInterrupts(t *testing.T) {
+
+	if runtime.GOOS == "windows" {
+		t.Skip("Signals cannot be sent to the process on Windows")
+	}
+	process, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	utils.ResetInterrupt()
+	defer utils.ResetInterrupt()
+
+	forceExit := utils.HandleInterrupts()
+	waitFor := func(description string, condition func() bool) {
+		deadline := time.Now().Add(5 * time.Second)
+		for !condition() {
+			if time.Now().After(deadline) {
+				t.Fatalf("Timed out waiting for %s", description)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	isClosed := func() bool {
+		select {
+		case <-forceExit:
+			return true
+		default:
+			return false
+		}
+	}
+
+	process.Signal(syscall.SIGTERM)
+	waitFor("the run to be interrupted", utils.IsInterrupted)
+	process.Signal(syscall.SIGTERM)
+	waitFor("the requests to be cancelled", func() bool { return utils.RequestContext().Err() != nil })
+	if isClosed() {
+		t.Errorf("Expected the process to continue after the second interrupt")
+	}
+
+	// The third interrupt is left to the caller, instead of exiting the process.
+	process.Signal(syscall.SIGTERM)
+	waitFor("the force exit to be signalled", isClosed)
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestGetExitCode(t *testing.T) {
+
+	tests := []struct {
+		description      string
+		summaries        map[utils.ResourceType]utils.ResourceTypeSummary
+		expectedExitCode int
+	}{
+		{
+			description: "No failures",
+			summaries: map[utils.ResourceType]utils.ResourceTypeSummary{
+				utils.APPLICATIONS: {SuccessfulImport: 2},
+				utils.CLAIMS:       {Skipped: true},
+			},
+			expectedExitCode: utils.EXIT_CODE_SUCCESS,
+		},
+		{
+			description: "Failed resources",
+			summaries: map[utils.ResourceType]utils.ResourceTypeSummary{
+				utils.APPLICATIONS: {SuccessfulImport: 2, FailedCount: 1},
+				utils.CLAIMS:       {SuccessfulImport: 1},
+			},
+			expectedExitCode: utils.EXIT_CODE_PARTIAL_FAILURE,
+		},
+		{
+			description: "Failed resource type",
+			summaries: map[utils.ResourceType]utils.ResourceTypeSummary{
+				utils.APPLICATIONS: {FailedCount: 1},
+				utils.CLAIMS:       {Failed: true},
+			},
+			expectedExitCode: utils.EXIT_CODE_RESOURCE_TYPE_FAILURE,
+		},
+	}
+
+	defer func() { utils.ResTypeSummaryMap = nil }()
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			utils.ResTypeSummaryMap = tc.summaries
+
+			exitCode := utils.GetExitCode()
+			if exitCode != tc.expectedExitCode {
+				t.Errorf("Unexpected result for %s: expected %d, but got %d", tc.description, tc.expectedExitCode, exitCode)
+			}
+		})
+	}
+}
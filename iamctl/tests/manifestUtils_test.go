@@ -0,0 +1,235 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+// Writes the exported resources and their manifest, with the identifier of App1 registered while exporting.
+func writeExportedResources(t *testing.T, files map[string]string) string {
+
+	dirPath := t.TempDir()
+	for relativePath, content := range files {
+		if err := writeFile(filepath.Join(dirPath, filepath.FromSlash(relativePath)), content); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	utils.ResetResourceIdentifierMap()
+	utils.AddToIdentifierMap(utils.APPLICATIONS, "app1-id", "App1", utils.EXPORT)
+	if err := utils.WriteManifest(dirPath); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return dirPath
+}
+
+func setManifestEnvironment(t *testing.T) {
+
+	originalServerConfigs, originalKeywordConfigs := utils.SERVER_CONFIGS, utils.KEYWORD_CONFIGS
+	t.Cleanup(func() {
+		utils.SERVER_CONFIGS, utils.KEYWORD_CONFIGS = originalServerConfigs, originalKeywordConfigs
+		utils.ResetResourceIdentifierMap()
+	})
+	utils.SERVER_CONFIGS = utils.ServerConfigs{ServerUrl: "https://dev.example.com", TenantDomain: "carbon.super",
+		ServerVersion: "7.1.0"}
+	utils.KEYWORD_CONFIGS = utils.KeywordConfigs{KeywordMappings: map[string]interface{}{"CALLBACK": "https://dev.example.com/cb"}}
+}
+
+func findManifestResource(manifest *utils.ExportManifest, file string) utils.ManifestResource {
+
+	for _, resource := range manifest.Resources {
+		if resource.File == file {
+			return resource
+		}
+	}
+	return utils.ManifestResource{}
+}
+
+var exportedResources = map[string]string{
+	"Applications/App1.yml":                           "applicationName: App1\ncallbackUrl: '{{CALLBACK}}'\n",
+	"Applications/ApplicationAuthorizedApis/App1.yml": "- identifier: orders_api\n",
+	"IdentityProviders/Google.yml":                    "name: Google\n",
+}
+
+func TestWriteManifest(t *testing.T) {
+
+	setManifestEnvironment(t)
+	dirPath := writeExportedResources(t, exportedResources)
+
+	manifest, err := utils.LoadManifest(dirPath)
+	if err != nil || manifest == nil {
+		t.Fatalf("Expected the manifest to be loaded, got error: %v", err)
+	}
+	if manifest.ServerUrl != "https://dev.example.com" || manifest.ServerVersion != "7.1.0" || manifest.ExportedAt.IsZero() {
+		t.Errorf("Expected the environment of the export in the manifest, got %+v", manifest)
+	}
+
+	expected := map[string]utils.ManifestResource{
+		"Applications/App1.yml":                           {ResourceType: utils.APPLICATIONS, Name: "App1", Id: "app1-id"},
+		"Applications/ApplicationAuthorizedApis/App1.yml": {ResourceType: utils.APPLICATIONS, Name: "App1", Id: "app1-id"},
+		"IdentityProviders/Google.yml":                    {ResourceType: utils.IDENTITY_PROVIDERS, Name: "Google"},
+	}
+	if len(manifest.Resources) != len(expected) {
+		t.Fatalf("Expected %d resource files, got %+v", len(expected), manifest.Resources)
+	}
+	for _, resource := range manifest.Resources {
+		expectedResource, exists := expected[resource.File]
+		if !exists || resource.ResourceType != expectedResource.ResourceType || resource.Name != expectedResource.Name ||
+			resource.Id != expectedResource.Id || resource.Hash == "" {
+			t.Errorf("Unexpected manifest entry %+v", resource)
+		}
+	}
+
+	t.Run("Hash matches the deployed resource", func(t *testing.T) {
+		deployedPath := filepath.Join(t.TempDir(), "App1.yml")
+		writeFile(deployedPath, "applicationName: App1\ncallbackUrl: https://dev.example.com/cb\n")
+		hash, err := utils.GetResourceFileHash(deployedPath, utils.APPLICATIONS, "App1")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if expected := findManifestResource(manifest, "Applications/App1.yml").Hash; hash != expected {
+			t.Errorf("Expected the hash %s of the local file, got %s", expected, hash)
+		}
+	})
+
+	t.Run("Identifiers kept from the previous manifest", func(t *testing.T) {
+		utils.ResetResourceIdentifierMap()
+		if err := utils.WriteManifest(dirPath); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		manifest, _ := utils.LoadManifest(dirPath)
+		if resource := findManifestResource(manifest, "Applications/App1.yml"); resource.Id != "app1-id" {
+			t.Errorf("Expected the identifier to be kept, got %+v", resource)
+		}
+	})
+}
+
+func TestDetectRenamedResources(t *testing.T) {
+
+	setManifestEnvironment(t)
+
+	tests := []struct {
+		description    string
+		renamedFiles   map[string]string
+		expectedRename *utils.ResourceRename
+	}{
+		{
+			description: "Renamed resource",
+			renamedFiles: map[string]string{
+				"Applications/App9.yml":                           "applicationName: App9\ncallbackUrl: '{{CALLBACK}}'\n",
+				"Applications/ApplicationAuthorizedApis/App9.yml": "- identifier: orders_api\n",
+			},
+			expectedRename: &utils.ResourceRename{ResourceType: utils.APPLICATIONS, OldName: "App1", NewName: "App9", Id: "app1-id"},
+		},
+		{
+			description: "Removed resource and a different new resource",
+			renamedFiles: map[string]string{
+				"Applications/App9.yml": "applicationName: App9\ncallbackUrl: https://other.example.com\n",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			dirPath := writeExportedResources(t, exportedResources)
+			os.Remove(filepath.Join(dirPath, "Applications", "App1.yml"))
+			os.Remove(filepath.Join(dirPath, "Applications", "ApplicationAuthorizedApis", "App1.yml"))
+			for relativePath, content := range tc.renamedFiles {
+				writeFile(filepath.Join(dirPath, filepath.FromSlash(relativePath)), content)
+			}
+
+			renames, err := utils.DetectRenamedResources(dirPath)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tc.expectedRename == nil {
+				if len(renames) != 0 {
+					t.Errorf("Expected no renames, got %+v", renames)
+				}
+				return
+			}
+			if len(renames) == 0 || renames[0] != *tc.expectedRename {
+				t.Errorf("Expected the rename %+v, got %+v", *tc.expectedRename, renames)
+			}
+		})
+	}
+}
+
+func TestDetectServerModifications(t *testing.T) {
+
+	setManifestEnvironment(t)
+	dirPath := writeExportedResources(t, exportedResources)
+	manifest, _ := utils.LoadManifest(dirPath)
+
+	deployedDir := t.TempDir()
+	writeFile(filepath.Join(deployedDir, "Applications", "App1.yml"), "applicationName: App1\ncallbackUrl: https://dev.example.com/cb\n")
+	writeFile(filepath.Join(deployedDir, "IdentityProviders", "Google.yml"), "name: Google\ndescription: changed\n")
+
+	tests := []struct {
+		description string
+		serverUrl   string
+		expected    []string
+	}{
+		{"Same environment", "https://dev.example.com", []string{"Google"}},
+		{"Other environment", "https://stage.example.com", nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			utils.SERVER_CONFIGS.ServerUrl = tc.serverUrl
+			modified, err := utils.DetectServerModifications(manifest, deployedDir)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			var names []string
+			for _, resource := range modified {
+				names = append(names, resource.Name)
+			}
+			if len(names) != len(tc.expected) || (len(names) > 0 && names[0] != tc.expected[0]) {
+				t.Errorf("Expected %v, got %v", tc.expected, names)
+			}
+		})
+	}
+}
+
+func TestWriteExportedFileUnchanged(t *testing.T) {
+
+	filePath := filepath.Join(t.TempDir(), "App1.yml")
+	writeFile(filePath, "applicationName: App1\n")
+	modTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	os.Chtimes(filePath, modTime, modTime)
+
+	if err := utils.WriteExportedFile(filePath, []byte("applicationName: App1\n")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if info, _ := os.Stat(filePath); !info.ModTime().Equal(modTime) {
+		t.Errorf("Expected the unchanged file not to be rewritten")
+	}
+	if err := utils.WriteExportedFile(filePath, []byte("applicationName: App2\n")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if info, _ := os.Stat(filePath); info.ModTime().Equal(modTime) {
+		t.Errorf("Expected the changed file to be rewritten")
+	}
+}
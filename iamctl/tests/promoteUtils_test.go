@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestAddKeywordPlaceholders(t *testing.T) {
+
+	originalKeywordConfigs := utils.KEYWORD_CONFIGS
+	defer func() { utils.KEYWORD_CONFIGS = originalKeywordConfigs }()
+
+	tests := []struct {
+		description    string
+		content        string
+		keywordMapping map[string]interface{}
+		expected       string
+	}{
+		{
+			"Values replaced with keywords",
+			"callbackUrl: https://dev.example.com/callback\ndescription: dev app\n",
+			map[string]interface{}{"CALLBACK_HOST": "https://dev.example.com"},
+			"callbackUrl: {{CALLBACK_HOST}}/callback\ndescription: dev app\n",
+		},
+		{
+			"Longer values replaced first",
+			"url: https://dev.example.com/portal\nhost: https://dev.example.com\n",
+			map[string]interface{}{"HOST": "https://dev.example.com", "PORTAL_URL": "https://dev.example.com/portal"},
+			"url: {{PORTAL_URL}}\nhost: {{HOST}}\n",
+		},
+		{
+			"Same value of several keywords replaced with the first keyword",
+			"clientId: shared-value\n",
+			map[string]interface{}{"B_KEYWORD": "shared-value", "A_KEYWORD": "shared-value"},
+			"clientId: {{A_KEYWORD}}\n",
+		},
+		{
+			"Values within longer words not replaced",
+			"name: developer\nurl: https://dev.example.community\nhost: dev.example.com\nenv: dev_stage\n",
+			map[string]interface{}{"HOST": "dev.example.com", "ENV": "dev_"},
+			"name: developer\nurl: https://dev.example.community\nhost: {{HOST}}\nenv: dev_stage\n",
+		},
+		{
+			"Adjacent occurrences within a word not replaced",
+			"name: testtest\nid: test-test\n",
+			map[string]interface{}{"NAME": "test"},
+			"name: testtest\nid: {{NAME}}-{{NAME}}\n",
+		},
+		{
+			"Repeated occurrences replaced",
+			"a: test test,test\nb: xtest test\n",
+			map[string]interface{}{"NAME": "test"},
+			"a: {{NAME}} {{NAME}},{{NAME}}\nb: xtest {{NAME}}\n",
+		},
+		{
+			"Repeated keyword placeholders not replaced",
+			"url: {{test}}{{test}} test\n",
+			map[string]interface{}{"NAME": "test"},
+			"url: {{test}}{{test}} {{NAME}}\n",
+		},
+		{
+			"Short values not replaced",
+			"name: dev app\nport: 1\n",
+			map[string]interface{}{"ENV": "dev", "PORT": "1"},
+			"name: dev app\nport: 1\n",
+		},
+		{
+			"Values within keyword placeholders not replaced",
+			"url: https://example.com/HOST\n",
+			map[string]interface{}{"HOST": "https://example.com", "NAME": "HOST"},
+			"url: {{HOST}}/{{NAME}}\n",
+		},
+		{
+			"Empty and non string values ignored",
+			"enabled: true\nname: app\n",
+			map[string]interface{}{"EMPTY": "", "ENABLED": true, "LIST": []interface{}{"app"}},
+			"enabled: true\nname: app\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			dirPath := t.TempDir()
+			filePath := filepath.Join(dirPath, "Applications", "app.yml")
+			if err := writeFile(filePath, tc.content); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			utils.KEYWORD_CONFIGS = utils.KeywordConfigs{KeywordMappings: tc.keywordMapping}
+			modified, err := utils.AddKeywordPlaceholders(dirPath)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			content, _ := ioutil.ReadFile(filePath)
+			if string(content) != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, string(content))
+			}
+			expectedModified := 0
+			if tc.expected != tc.content {
+				expectedModified = 1
+			}
+			if modified != expectedModified {
+				t.Errorf("Expected %d modified files, got %d", expectedModified, modified)
+			}
+		})
+	}
+}
+
+func TestAddResourceKeywordPlaceholders(t *testing.T) {
+
+	originalKeywordConfigs := utils.KEYWORD_CONFIGS
+	defer func() { utils.KEYWORD_CONFIGS = originalKeywordConfigs }()
+	utils.KEYWORD_CONFIGS = utils.KeywordConfigs{
+		KeywordMappings: map[string]interface{}{"HOST": "https://dev.example.com"},
+		ApplicationConfigs: map[string]interface{}{
+			"App1": map[string]interface{}{
+				utils.KEYWORD_MAPPINGS_CONFIG: map[string]interface{}{"APP_CALLBACK": "https://app1.dev.example.com/cb"},
+			},
+		},
+	}
+
+	dirPath := t.TempDir()
+	content := "host: https://dev.example.com\ncallbackUrl: https://app1.dev.example.com/cb\n"
+	files := map[string]string{
+		filepath.Join(dirPath, "Applications", "App1.yml"): "host: {{HOST}}\ncallbackUrl: {{APP_CALLBACK}}\n",
+		filepath.Join(dirPath, "Applications", "App2.yml"): "host: {{HOST}}\ncallbackUrl: https://app1.dev.example.com/cb\n",
+	}
+	for filePath := range files {
+		if err := writeFile(filePath, content); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if _, err := utils.AddKeywordPlaceholders(dirPath); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for filePath, expected := range files {
+		if content, _ := ioutil.ReadFile(filePath); string(content) != expected {
+			t.Errorf("Expected %q in %s, got %q", expected, filepath.Base(filePath), string(content))
+		}
+	}
+}
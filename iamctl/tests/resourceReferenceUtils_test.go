@@ -95,6 +95,14 @@ func TestExtractAndRegisterIdentifier(t *testing.T) {
 			if !reflect.DeepEqual(result, tc.expectedMap) {
 				t.Errorf("Unexpected result for %s: expected %v, but got %v", tc.description, tc.expectedMap, result)
 			}
+			for key, expected := range tc.expectedMap {
+				if value, exists := utils.GetResourceIdentifier(tc.resourceType, key); !exists || value != expected {
+					t.Errorf("Expected the identifier %s for %s, got %s", expected, key, value)
+				}
+			}
+			if _, exists := utils.GetResourceIdentifier(tc.resourceType, "unknown"); exists {
+				t.Errorf("Expected no identifier for an unregistered key")
+			}
 		})
 	}
 }
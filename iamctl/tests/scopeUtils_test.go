@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestGetRequiredScopes(t *testing.T) {
+
+	tests := []struct {
+		description    string
+		includeOnly    []string
+		exclude        []string
+		allowDelete    bool
+		operation      string
+		expectedScopes []string
+	}{
+		{
+			description:    "Export requires only the view scopes",
+			includeOnly:    []string{"BrandingPreferences", "EmailTemplates"},
+			operation:      utils.EXPORT,
+			expectedScopes: []string{"internal_email_mgt_view"},
+		},
+		{
+			description: "Import requires the create and update scopes",
+			includeOnly: []string{"BrandingPreferences", "EmailTemplates"},
+			operation:   utils.IMPORT,
+			expectedScopes: []string{"internal_branding_preference_update", "internal_email_mgt_create",
+				"internal_email_mgt_update", "internal_email_mgt_view"},
+		},
+		{
+			description: "Import requires the delete scopes if deleting is allowed",
+			includeOnly: []string{"EmailTemplates"},
+			allowDelete: true,
+			operation:   utils.IMPORT,
+			expectedScopes: []string{"internal_email_mgt_create", "internal_email_mgt_delete",
+				"internal_email_mgt_update", "internal_email_mgt_view"},
+		},
+		{
+			description: "Rollback requires the delete scopes",
+			includeOnly: []string{"OidcScopes"},
+			operation:   utils.ROLLBACK,
+			expectedScopes: []string{"internal_oidc_scope_mgt_create", "internal_oidc_scope_mgt_delete",
+				"internal_oidc_scope_mgt_update", "internal_oidc_scope_mgt_view"},
+		},
+		{
+			description: "Import requires the view scopes of the referenced resource types",
+			includeOnly: []string{"Roles"},
+			operation:   utils.IMPORT,
+			expectedScopes: []string{"internal_application_mgt_client_secret_view", "internal_application_mgt_view",
+				"internal_org_application_mgt_client_secret_view", "internal_org_application_mgt_view",
+				"internal_role_mgt_create", "internal_role_mgt_permissions_update", "internal_role_mgt_update",
+				"internal_role_mgt_view"},
+		},
+		{
+			description: "Excluded resource types do not require scopes",
+			exclude: []string{"Applications", "IdentityProviders", "Claims", "UserStores", "OidcScopes", "Roles",
+				"ChallengeQuestions", "EmailTemplates", "ScriptLibraries", "GovernanceConnectors", "Certificates",
+				"Workflows", "ApiResources", "ValidationRules", "Organizations", "EmailProviders", "SmsProviders",
+				"SmsTemplates", "Actions", "BrandingPreferences", "CustomTexts"},
+			operation:      utils.EXPORT,
+			expectedScopes: []string{"internal_flow_view"},
+		},
+	}
+
+	defer func() { utils.TOOL_CONFIGS = utils.ToolConfigs{} }()
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			utils.TOOL_CONFIGS = utils.ToolConfigs{
+				IncludeOnly: tc.includeOnly,
+				Exclude:     tc.exclude,
+				AllowDelete: tc.allowDelete,
+			}
+			scopes := utils.GetRequiredScopes(tc.operation)
+			if !reflect.DeepEqual(scopes, tc.expectedScopes) {
+				t.Errorf("Expected scopes %v, got %v", tc.expectedScopes, scopes)
+			}
+		})
+	}
+}
+
+func TestGetMissingScopes(t *testing.T) {
+
+	tests := []struct {
+		description     string
+		grantedScope    string
+		expectedMissing []string
+	}{
+		{
+			description:  "All scopes granted",
+			grantedScope: "internal_email_mgt_view internal_email_mgt_update openid",
+		},
+		{
+			description:     "Some scopes missing",
+			grantedScope:    "internal_email_mgt_view",
+			expectedMissing: []string{"internal_email_mgt_update"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			missing := utils.GetMissingScopes([]string{"internal_email_mgt_view", "internal_email_mgt_update"},
+				tc.grantedScope)
+			if !reflect.DeepEqual(missing, tc.expectedMissing) {
+				t.Errorf("Expected missing scopes %v, got %v", tc.expectedMissing, missing)
+			}
+		})
+	}
+}
+
+func TestAccessTokenRequestedScopes(t *testing.T) {
+
+	mockServer := &mockAuthServer{}
+	server := httptest.NewServer(mockServer)
+	defer server.Close()
+
+	defer func() { utils.SERVER_CONFIGS = utils.ServerConfigs{} }()
+	utils.SERVER_CONFIGS = utils.ServerConfigs{
+		ServerUrl:    server.URL,
+		TenantDomain: "carbon.super",
+	}
+	scopes := []string{"internal_email_mgt_view", "internal_email_mgt_update"}
+	if err := utils.InitAccessToken(scopes); err != nil {
+		t.Fatalf("Unexpected error getting the access token: %v", err)
+	}
+	if scope := mockServer.forms[0].Get("scope"); scope != strings.Join(scopes, " ") {
+		t.Errorf("Expected the scope %q, got %q", strings.Join(scopes, " "), scope)
+	}
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/client"
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func newTokenServer(accessToken string) *httptest.Server {
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/oauth2/token") {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token":"` + accessToken + `","expires_in":3600}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+}
+
+func TestSessions(t *testing.T) {
+
+	originalServerConfigs := utils.SERVER_CONFIGS
+	defer func() { utils.SERVER_CONFIGS = originalServerConfigs }()
+
+	devServer := newTokenServer("dev-token")
+	defer devServer.Close()
+	prodServer := newTokenServer("prod-token")
+	defer prodServer.Close()
+
+	newSession := func(serverUrl string) *utils.Session {
+		configDir := writeConfigFiles(t, `{"SERVER_URL": "`+serverUrl+`", "CLIENT_ID": "id", "CLIENT_SECRET": "secret", `+
+			`"SERVER_VERSION": "7.1.0"}`, "{}", "{}")
+		session, err := utils.NewSession(configDir, utils.EXPORT)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return session
+	}
+	devSession := newSession(devServer.URL)
+	prodSession := newSession(prodServer.URL)
+
+	if utils.SERVER_CONFIGS.ServerUrl != originalServerConfigs.ServerUrl {
+		t.Errorf("Expected the configs outside the sessions to be unchanged, got %s", utils.SERVER_CONFIGS.ServerUrl)
+	}
+
+	originalCorrelationId, originalConcurrency := utils.GetCorrelationId(), utils.Concurrency
+	devSession.Run(func() {
+		utils.UpdateSuccessSummary(utils.APPLICATIONS, utils.EXPORT)
+		utils.AddToIdentifierMap(utils.APPLICATIONS, "dev-id", "app1", utils.EXPORT)
+		utils.SetCorrelationId("dev-correlation-id")
+		utils.SetConcurrency(4)
+	})
+	if utils.GetCorrelationId() != originalCorrelationId || utils.Concurrency != originalConcurrency {
+		t.Errorf("Expected the run state outside the sessions to be unchanged, got %s with concurrency %d",
+			utils.GetCorrelationId(), utils.Concurrency)
+	}
+	prodSession.Run(func() {
+		utils.AddToIdentifierMap(utils.APPLICATIONS, "prod-id", "app1", utils.EXPORT)
+	})
+
+	tests := []struct {
+		description  string
+		session      *utils.Session
+		serverUrl    string
+		token        string
+		identifier   string
+		successCount int
+	}{
+		{"Dev session", devSession, devServer.URL, "dev-token", "dev-id", 1},
+		{"Prod session", prodSession, prodServer.URL, "prod-token", "prod-id", 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			tc.session.Run(func() {
+				if utils.SERVER_CONFIGS.ServerUrl != tc.serverUrl || utils.SERVER_CONFIGS.Token != tc.token {
+					t.Errorf("Expected server %s with token %s, got %s with token %s", tc.serverUrl, tc.token,
+						utils.SERVER_CONFIGS.ServerUrl, utils.SERVER_CONFIGS.Token)
+				}
+				if (utils.GetCorrelationId() == "dev-correlation-id") != (tc.session == devSession) {
+					t.Errorf("Expected the correlation ID to be kept in the dev session, got %s", utils.GetCorrelationId())
+				}
+				identifiers := utils.GetResourceIdentifierMap(utils.APPLICATIONS)
+				if _, exists := identifiers[tc.identifier]; !exists || len(identifiers) != 1 {
+					t.Errorf("Expected only the identifier of the session, got %v", identifiers)
+				}
+			})
+			report := tc.session.SummaryReport(utils.EXPORT)
+			if report.SuccessfulOperations != tc.successCount {
+				t.Errorf("Expected %d successful exports, got %d", tc.successCount, report.SuccessfulOperations)
+			}
+		})
+	}
+}
+
+func TestClientUnsupportedResourceType(t *testing.T) {
+
+	server := newTokenServer("token")
+	defer server.Close()
+	configDir := writeConfigFiles(t, `{"SERVER_URL": "`+server.URL+`", "CLIENT_ID": "id", "CLIENT_SECRET": "secret", `+
+		`"SERVER_VERSION": "7.1.0"}`, "{}", "{}")
+
+	iamClient, err := client.New(configDir, utils.EXPORT)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := iamClient.Export("Unknown", t.TempDir(), "yaml"); err == nil {
+		t.Errorf("Expected an error for an unsupported resource type")
+	}
+	if err := iamClient.Import(utils.BRANDING_PREFERENCES, t.TempDir()); err == nil {
+		t.Errorf("Expected an error for a sub resource type")
+	}
+}
+
+func TestClientOperationErrors(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/oauth2/token") {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token":"token","expires_in":3600}`))
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	configDir := writeConfigFiles(t, `{"SERVER_URL": "`+server.URL+`", "CLIENT_ID": "id", "CLIENT_SECRET": "secret", `+
+		`"SERVER_VERSION": "7.1.0"}`, `{"HTTP": {"MAX_RETRIES": 0}}`, "{}")
+
+	iamClient, err := client.New(configDir, utils.EXPORT)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	err = iamClient.Export(utils.IDENTITY_PROVIDERS, t.TempDir(), "yaml")
+	var operationError *utils.OperationError
+	if !errors.As(err, &operationError) || len(operationError.ResourceTypes) != 1 ||
+		operationError.ResourceTypes[0].ResourceType != utils.IDENTITY_PROVIDERS {
+		t.Fatalf("Expected an operation error for the identity providers, got %v", err)
+	}
+	// Failures recorded by earlier operations are not returned again.
+	if err := iamClient.Export(utils.OIDC_SCOPES, t.TempDir(), "yaml"); !errors.As(err, &operationError) ||
+		len(operationError.ResourceTypes) != 1 || operationError.ResourceTypes[0].ResourceType != utils.OIDC_SCOPES {
+		t.Errorf("Expected an operation error for the OIDC scopes only, got %v", err)
+	}
+}
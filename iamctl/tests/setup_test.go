@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func writeConfigFiles(t *testing.T, serverConfig, toolConfig, keywordConfig string) string {
+
+	configDir := filepath.Join(t.TempDir(), "configs", "dev")
+	files := map[string]string{
+		utils.SERVER_CONFIG_FILE:  serverConfig,
+		utils.TOOL_CONFIG_FILE:    toolConfig,
+		utils.KEYWORD_CONFIG_FILE: keywordConfig,
+	}
+	for name, content := range files {
+		if content == "" {
+			continue
+		}
+		if err := writeFile(filepath.Join(configDir, name), content); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	return configDir
+}
+
+func writeFile(path, content string) error {
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, []byte(content), 0644)
+}
+
+func TestLoadConfigsErrors(t *testing.T) {
+
+	originalConfigs := utils.SERVER_CONFIGS
+	defer func() {
+		utils.SERVER_CONFIGS = originalConfigs
+		utils.InitHTTPClient(utils.DefaultHttpConfigs())
+	}()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":"invalid_client","error_description":"Client authentication failed."}`))
+	}))
+	defer server.Close()
+
+	validServerConfig := `{"SERVER_URL": "` + server.URL + `", "CLIENT_ID": "id", "CLIENT_SECRET": "secret", "SERVER_VERSION": "7.1.0"}`
+	tests := []struct {
+		description   string
+		serverConfig  string
+		toolConfig    string
+		expectConfig  bool
+		expectAuth    bool
+		expectMessage string
+	}{
+		{"Missing server config file", "", "{}", true, false, "error when reading the server config file"},
+		{"Malformed server config file", `{"SERVER_URL": `, "{}", true, false, "server configs are not in the correct format"},
+		{"Invalid server version", `{"SERVER_URL": "https://localhost:9443", "SERVER_VERSION": "seven"}`, "{}", true, false,
+			"unexpected format for the server version: seven"},
+		{"Malformed tool config file", validServerConfig, `{"EXCLUDE": "Claims"`, true, false,
+			"tool configs are not in the correct format"},
+		{"Missing server URL", `{"CLIENT_ID": "id", "CLIENT_SECRET": "secret", "SERVER_VERSION": "7.1.0"}`, "{}", true, false,
+			"server URL is not defined"},
+		{"Rejected client credentials", validServerConfig, "{}", false, true, "error in getting access token"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			utils.SERVER_CONFIGS = utils.ServerConfigs{}
+			configDir := writeConfigFiles(t, tc.serverConfig, tc.toolConfig, "{}")
+
+			_, err := utils.LoadConfigs(configDir, utils.EXPORT)
+			if err == nil {
+				t.Fatalf("Expected an error")
+			}
+			var configError *utils.ConfigError
+			var authError *utils.AuthError
+			if errors.As(err, &configError) != tc.expectConfig {
+				t.Errorf("Expected config error: %v, got %T: %v", tc.expectConfig, err, err)
+			}
+			if errors.As(err, &authError) != tc.expectAuth {
+				t.Errorf("Expected auth error: %v, got %T: %v", tc.expectAuth, err, err)
+			}
+			if !strings.Contains(err.Error(), tc.expectMessage) {
+				t.Errorf("Expected the error to contain %q, got %q", tc.expectMessage, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestCreateSnapshot(t *testing.T) {
+
+	snapshotDir, err := ioutil.TempDir("", "iamctl-snapshot-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(snapshotDir)
+
+	utils.SERVER_CONFIGS.ServerUrl = "https://localhost:9443"
+	utils.SERVER_CONFIGS.TenantDomain = "carbon.super"
+	defer func() { utils.SERVER_CONFIGS = utils.ServerConfigs{} }()
+
+	deployedFiles := []string{
+		"Applications/App1.yml",
+		"Applications/App2.yml",
+		"Applications/App3.yml",
+		"Applications/ApplicationAuthorizedApis/App1.yml",
+		"Roles/Application%2Fmanager.yml",
+		"Claims/claim.yml",
+	}
+	for _, relativePath := range deployedFiles {
+		filePath := filepath.Join(snapshotDir, filepath.FromSlash(relativePath))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filePath, []byte("name: test\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	plans := []utils.ResourceTypePlan{
+		{ResourceType: utils.APPLICATIONS, Changes: []utils.ResourceChange{
+			{ResourceType: utils.APPLICATIONS, ResourceName: "App1", Action: utils.PLAN_NO_OP},
+			{ResourceType: utils.APPLICATIONS, ResourceName: "ApplicationAuthorizedApis/App1", Action: utils.PLAN_UPDATE},
+			{ResourceType: utils.APPLICATIONS, ResourceName: "App2", Action: utils.PLAN_NO_OP},
+			{ResourceType: utils.APPLICATIONS, ResourceName: "App3", Action: utils.PLAN_DELETE},
+			{ResourceType: utils.APPLICATIONS, ResourceName: "App4", Action: utils.PLAN_CREATE},
+		}},
+		{ResourceType: utils.ROLES, Changes: []utils.ResourceChange{
+			{ResourceType: utils.ROLES, ResourceName: "Application%2Fmanager", Action: utils.PLAN_UPDATE},
+		}},
+		{ResourceType: utils.CLAIMS, Changes: []utils.ResourceChange{
+			{ResourceType: utils.CLAIMS, ResourceName: "claim", Action: utils.PLAN_NO_OP},
+		}},
+	}
+
+	manifest, err := utils.CreateSnapshot(snapshotDir, plans)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedChanges := map[utils.ResourceType]utils.SnapshotChanges{
+		utils.APPLICATIONS: {Created: []string{"App4"}, Updated: []string{"App1"}, Deleted: []string{"App3"}},
+		utils.ROLES:        {Updated: []string{"Application/manager"}},
+	}
+	if !reflect.DeepEqual(manifest.Changes, expectedChanges) {
+		t.Errorf("Expected changes %v, got %v", expectedChanges, manifest.Changes)
+	}
+
+	var snapshotFiles []string
+	filepath.Walk(snapshotDir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			relativePath, _ := filepath.Rel(snapshotDir, path)
+			snapshotFiles = append(snapshotFiles, filepath.ToSlash(relativePath))
+		}
+		return nil
+	})
+	sort.Strings(snapshotFiles)
+	expectedFiles := []string{
+		"Applications/App1.yml",
+		"Applications/App3.yml",
+		"Applications/ApplicationAuthorizedApis/App1.yml",
+		"Roles/Application%2Fmanager.yml",
+		utils.SNAPSHOT_MANIFEST_FILE,
+	}
+	if !reflect.DeepEqual(snapshotFiles, expectedFiles) {
+		t.Errorf("Expected snapshot files %v, got %v", expectedFiles, snapshotFiles)
+	}
+
+	loaded, err := utils.LoadSnapshot(snapshotDir)
+	if err != nil {
+		t.Fatalf("Unexpected error loading the snapshot: %v", err)
+	}
+	selection := loaded.GetRollbackSelection()
+	expectedSelection := map[utils.ResourceType][]string{
+		utils.APPLICATIONS: {"App4", "App1", "App3"},
+		utils.ROLES:        {"Application/manager"},
+	}
+	if !reflect.DeepEqual(selection, expectedSelection) {
+		t.Errorf("Expected rollback selection %v, got %v", expectedSelection, selection)
+	}
+
+	utils.SERVER_CONFIGS.TenantDomain = "other.com"
+	if _, err := utils.LoadSnapshot(snapshotDir); err == nil {
+		t.Error("Expected an error loading a snapshot of a different environment")
+	}
+}
+
+func TestGetDefaultSnapshotDirPath(t *testing.T) {
+
+	homeDir := t.TempDir()
+	t.Setenv("HOME", homeDir)
+
+	snapshotDirPath := utils.GetDefaultSnapshotDirPath()
+	if filepath.Dir(snapshotDirPath) != filepath.Join(homeDir, utils.MANIFEST_DIR_NAME, utils.SNAPSHOTS_DIR_NAME) {
+		t.Errorf("Expected the snapshot directory in the home directory, got %s", snapshotDirPath)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestFormatSummaryReport(t *testing.T) {
+
+	utils.ResTypeSummaryMap = map[utils.ResourceType]utils.ResourceTypeSummary{
+		utils.APPLICATIONS: {ResourceType: utils.APPLICATIONS, SuccessfulImport: 2, FailedCount: 1,
+			FailedResources: []string{"Pickup App"}, Duration: 1500 * time.Millisecond},
+		utils.CLAIMS:      {ResourceType: utils.CLAIMS, Skipped: true, SkipReason: "excluded"},
+		utils.OIDC_SCOPES: {ResourceType: utils.OIDC_SCOPES, SuccessfulUpdate: 3},
+	}
+	utils.AggregatedSummary = utils.Summary{SuccessfulOperations: 5, FailedOperations: 1, TotalRequests: 6}
+	utils.Warnings = []string{"Applications - warning"}
+	defer utils.ResetSummary()
+
+	report := utils.BuildSummaryReport(utils.IMPORT)
+	if len(report.ResourceTypes) != 3 || report.ResourceTypes[0].ResourceType != utils.APPLICATIONS {
+		t.Fatalf("Expected the resource types sorted by name, got %v", report.ResourceTypes)
+	}
+	expectedStatuses := map[utils.ResourceType]string{
+		utils.APPLICATIONS: utils.RESOURCE_TYPE_STATUS_FAILED,
+		utils.CLAIMS:       utils.RESOURCE_TYPE_STATUS_SKIPPED,
+		utils.OIDC_SCOPES:  utils.RESOURCE_TYPE_STATUS_SUCCESS,
+	}
+	for _, resourceType := range report.ResourceTypes {
+		if resourceType.Status != expectedStatuses[resourceType.ResourceType] {
+			t.Errorf("Expected status %s for %s, got %s", expectedStatuses[resourceType.ResourceType],
+				resourceType.ResourceType, resourceType.Status)
+		}
+	}
+
+	tests := []struct {
+		format       string
+		expectedSubs []string
+		expectError  bool
+	}{
+		{utils.SUMMARY_FORMAT_JSON, []string{`"operation": "import"`, `"failedResources": [`, `"Pickup App"`,
+			`"durationMs": 1500`, `"Applications - warning"`}, false},
+		{utils.SUMMARY_FORMAT_JUNIT, []string{`<testsuites name="iamctl import" tests="4" failures="2" skipped="1"`,
+			`<testsuite name="Applications" tests="2" failures="2" skipped="0" time="1.500">`,
+			`<testcase name="Pickup App" classname="Applications">`, `<skipped message="excluded">`}, false},
+		{"yaml", nil, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.format, func(t *testing.T) {
+			data, err := utils.FormatSummaryReport(report, tc.format)
+			if (err != nil) != tc.expectError {
+				t.Fatalf("Unexpected error result: %v", err)
+			}
+			for _, sub := range tc.expectedSubs {
+				if !strings.Contains(string(data), sub) {
+					t.Errorf("Expected output to contain %q, got:\n%s", sub, data)
+				}
+			}
+			if tc.format == utils.SUMMARY_FORMAT_JSON && !json.Valid(data) {
+				t.Errorf("Expected valid JSON, got:\n%s", data)
+			}
+		})
+	}
+}
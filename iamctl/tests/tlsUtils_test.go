@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestBuildTLSConfig(t *testing.T) {
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	tempDir, err := ioutil.TempDir("", "iamctl-tls-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	caCertPath := filepath.Join(tempDir, "ca.pem")
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := ioutil.WriteFile(caCertPath, caCert, 0644); err != nil {
+		t.Fatal(err)
+	}
+	fingerprint := sha256.Sum256(server.Certificate().Raw)
+	pin := strings.ToUpper(hex.EncodeToString(fingerprint[:]))
+	otherPin := strings.Repeat("ab", sha256.Size)
+
+	tests := []struct {
+		description        string
+		serverConfigs      utils.ServerConfigs
+		expectConfigError  bool
+		expectRequestError bool
+	}{
+		{
+			description:        "Untrusted certificate is rejected by default",
+			serverConfigs:      utils.ServerConfigs{},
+			expectRequestError: true,
+		},
+		{
+			description:   "Certificate trusted by the CA bundle",
+			serverConfigs: utils.ServerConfigs{CaCertPath: caCertPath},
+		},
+		{
+			description:   "Pinned certificate",
+			serverConfigs: utils.ServerConfigs{CaCertPath: caCertPath, PinnedCertificates: []string{otherPin, pin}},
+		},
+		{
+			description:        "Certificate not matching the pins",
+			serverConfigs:      utils.ServerConfigs{InsecureSkipVerify: true, PinnedCertificates: []string{otherPin}},
+			expectRequestError: true,
+		},
+		{
+			description:   "Certificate verification skipped",
+			serverConfigs: utils.ServerConfigs{InsecureSkipVerify: true},
+		},
+		{
+			description:       "Invalid pin",
+			serverConfigs:     utils.ServerConfigs{PinnedCertificates: []string{"not-a-fingerprint"}},
+			expectConfigError: true,
+		},
+		{
+			description:       "Client certificate without a key",
+			serverConfigs:     utils.ServerConfigs{ClientCertPath: caCertPath},
+			expectConfigError: true,
+		},
+		{
+			description:       "Missing CA bundle",
+			serverConfigs:     utils.ServerConfigs{CaCertPath: filepath.Join(tempDir, "missing.pem")},
+			expectConfigError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			config, err := utils.BuildTLSConfig(tc.serverConfigs)
+			if (err != nil) != tc.expectConfigError {
+				t.Fatalf("Unexpected config error result: %v", err)
+			}
+			if err != nil {
+				return
+			}
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: config}}
+			resp, err := client.Get(server.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if (err != nil) != tc.expectRequestError {
+				t.Errorf("Unexpected request error result: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+// Mock server issuing access tokens and accepting only the latest token that is not revoked.
+type mockTokenServer struct {
+	lock        sync.Mutex
+	issuedCount int
+	expiresIn   int
+	revoked     bool
+	grantTypes  []string
+	apiRequests int
+}
+
+func (m *mockTokenServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if r.URL.Path == "/t/carbon.super/oauth2/token" {
+		r.ParseForm()
+		m.grantTypes = append(m.grantTypes, r.PostForm.Get("grant_type"))
+		m.issuedCount++
+		m.revoked = false
+		fmt.Fprintf(w, `{"access_token": "token-%d", "expires_in": %d}`, m.issuedCount, m.expiresIn)
+		return
+	}
+	m.apiRequests++
+	if m.revoked || r.Header.Get("Authorization") != fmt.Sprintf("Bearer token-%d", m.issuedCount) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestAccessTokenRefresh(t *testing.T) {
+
+	tests := []struct {
+		description      string
+		organization     string
+		expiresIn        int
+		revoke           bool
+		wait             time.Duration
+		expectedIssued   int
+		expectedRequests int
+		expectedGrants   []string
+	}{
+		{
+			description:      "Valid token is reused",
+			expiresIn:        3600,
+			expectedIssued:   1,
+			expectedRequests: 1,
+			expectedGrants:   []string{"client_credentials"},
+		},
+		{
+			description:      "Token about to expire is refreshed before the request",
+			expiresIn:        1,
+			wait:             600 * time.Millisecond,
+			expectedIssued:   2,
+			expectedRequests: 1,
+			expectedGrants:   []string{"client_credentials", "client_credentials"},
+		},
+		{
+			description:      "Request is retried once with a new token after a 401",
+			expiresIn:        3600,
+			revoke:           true,
+			expectedIssued:   2,
+			expectedRequests: 2,
+			expectedGrants:   []string{"client_credentials", "client_credentials"},
+		},
+		{
+			description:      "Organization token is refreshed",
+			organization:     "sub-org",
+			expiresIn:        1,
+			wait:             600 * time.Millisecond,
+			expectedIssued:   4,
+			expectedRequests: 1,
+			expectedGrants:   []string{"client_credentials", "organization_switch", "client_credentials", "organization_switch"},
+		},
+	}
+
+	defer func() { utils.SERVER_CONFIGS = utils.ServerConfigs{} }()
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			mockServer := &mockTokenServer{expiresIn: tc.expiresIn}
+			server := httptest.NewServer(mockServer)
+			defer server.Close()
+
+			utils.SERVER_CONFIGS = utils.ServerConfigs{
+				ServerUrl:    server.URL,
+				TenantDomain: "carbon.super",
+				Organization: tc.organization,
+			}
+			if err := utils.InitAccessToken(nil); err != nil {
+				t.Fatalf("Unexpected error getting the access token: %v", err)
+			}
+			mockServer.lock.Lock()
+			mockServer.revoked = tc.revoke
+			mockServer.lock.Unlock()
+			time.Sleep(tc.wait)
+
+			resp, err := utils.SendCustomRequest(http.MethodGet, server.URL+"/api/resource", nil, "")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("Expected status 200, got %d", resp.StatusCode)
+			}
+			if mockServer.issuedCount != tc.expectedIssued || mockServer.apiRequests != tc.expectedRequests {
+				t.Errorf("Expected %d tokens and %d requests, got %d tokens and %d requests", tc.expectedIssued,
+					tc.expectedRequests, mockServer.issuedCount, mockServer.apiRequests)
+			}
+			if fmt.Sprint(mockServer.grantTypes) != fmt.Sprint(tc.expectedGrants) {
+				t.Errorf("Expected grant types %v, got %v", tc.expectedGrants, mockServer.grantTypes)
+			}
+		})
+	}
+}
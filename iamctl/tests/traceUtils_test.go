@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tests
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/wso2-extensions/identity-tools-cli/iamctl/pkg/utils"
+)
+
+func TestCorrelationHeaders(t *testing.T) {
+
+	configs := utils.DefaultHttpConfigs()
+	configs.MaxRetries = 1
+	configs.RetryBaseDelayMs = 1
+	configs.RetryMaxDelayMs = 10
+	configs.SendRequestId = true
+	utils.InitHTTPClient(configs)
+	defer utils.InitHTTPClient(utils.DefaultHttpConfigs())
+
+	var lock sync.Mutex
+	var correlationIds, requestIds []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		correlationIds = append(correlationIds, r.Header.Get(utils.CORRELATION_ID_HEADER))
+		requestIds = append(requestIds, r.Header.Get(utils.REQUEST_ID_HEADER))
+		// Reject the first attempt of each request.
+		if len(requestIds)%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	for i := 0; i < 2; i++ {
+		resp, err := utils.SendCustomRequest(http.MethodGet, server.URL, nil, "")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if len(requestIds) != 4 {
+		t.Fatalf("Expected 4 attempts, got %d", len(requestIds))
+	}
+	for _, correlationId := range correlationIds {
+		if correlationId != utils.GetCorrelationId() {
+			t.Errorf("Expected correlation ID %q, got %q", utils.GetCorrelationId(), correlationId)
+		}
+	}
+	if requestIds[0] == "" || requestIds[0] != requestIds[1] || requestIds[2] != requestIds[3] {
+		t.Errorf("Expected the retries to be sent with the request ID of the request, got %v", requestIds)
+	}
+	if requestIds[0] == requestIds[2] {
+		t.Errorf("Expected a different request ID for each request, got %v", requestIds)
+	}
+}
+
+func TestTraceFile(t *testing.T) {
+
+	utils.InitHTTPClient(utils.DefaultHttpConfigs())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=cookie-value")
+		w.Write([]byte(`{"access_token":"token-value","name":"app1"}`))
+	}))
+	defer server.Close()
+
+	traceFilePath := filepath.Join(t.TempDir(), "trace.har")
+	if err := utils.StartTrace(traceFilePath); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer utils.StopTrace()
+
+	for i := 0; i < 2; i++ {
+		resp, err := utils.SendCustomRequest(http.MethodPost, server.URL,
+			[]byte(`{"name":"app1","clientSecret":"secret-value"}`), "application/json")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if !strings.Contains(string(body), "token-value") {
+			t.Errorf("Expected the response body to be readable after tracing, got %q", body)
+		}
+	}
+
+	data, err := ioutil.ReadFile(traceFilePath)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var trace struct {
+		Log struct {
+			CorrelationId string `json:"_correlationId"`
+			Entries       []struct {
+				Request struct {
+					Method   string
+					PostData struct{ Text string }
+				}
+				Response struct {
+					Status  int
+					Content struct{ Text string }
+				}
+				CorrelationId string `json:"_correlationId"`
+			}
+		}
+	}
+	if err := json.Unmarshal(data, &trace); err != nil {
+		t.Fatalf("Expected a valid trace file, got error: %v", err)
+	}
+	if len(trace.Log.Entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(trace.Log.Entries))
+	}
+	entry := trace.Log.Entries[0]
+	if entry.Request.Method != http.MethodPost || entry.Response.Status != http.StatusOK {
+		t.Errorf("Unexpected entry: %+v", entry)
+	}
+	if entry.CorrelationId != utils.GetCorrelationId() || trace.Log.CorrelationId != utils.GetCorrelationId() {
+		t.Errorf("Expected the correlation ID %q in the trace", utils.GetCorrelationId())
+	}
+	if !strings.Contains(entry.Request.PostData.Text, "app1") {
+		t.Errorf("Expected the request body in the trace, got %q", entry.Request.PostData.Text)
+	}
+	for _, secret := range []string{"secret-value", "token-value", "cookie-value"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("Expected %q to be redacted from the trace", secret)
+		}
+	}
+}
+
+func TestRedactSecrets(t *testing.T) {
+
+	tests := []struct {
+		description string
+		payload     string
+		expected    string
+	}{
+		{"JSON secret", `{"clientSecret": "abc", "name": "app"}`, `{"clientSecret": "********", "name": "app"}`},
+		{"JSON token", `{"access_token":"abc","token_type":"Bearer"}`, `{"access_token":"********","token_type":"Bearer"}`},
+		{"JSON property", `{"name":"ClientSecret","value":"abc"}`, `{"name":"ClientSecret","value":"********"}`},
+		{"Form", "grant_type=client_credentials&client_assertion=abc&scope=x",
+			"grant_type=client_credentials&client_assertion=********&scope=x"},
+		{"YAML", "name: app\n  password: abc\n", "name: app\n  password: ********\n"},
+		{"YAML property", "- name: ClientSecret\n  value: abc\n", "- name: ClientSecret\n  value: ********\n"},
+		{"XML", "<Property><ConnectionPassword>abc</ConnectionPassword></Property>",
+			"<Property><ConnectionPassword>********</ConnectionPassword></Property>"},
+		{"No secrets", `{"tokenEndpointAuthMethod":"basic"}`, `{"tokenEndpointAuthMethod":"basic"}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			if actual := utils.RedactSecrets(tc.payload); actual != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
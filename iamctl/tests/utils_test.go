@@ -88,3 +88,40 @@ func TestIsResourceExcluded(t *testing.T) {
 		})
 	}
 }
+
+func TestIncludeOnlyResource(t *testing.T) {
+	testCases := []struct {
+		name         string
+		resourceType utils.ResourceType
+		resourceName string
+		expectError  bool
+	}{
+		{"Resource type only", utils.APPLICATIONS, "", false},
+		{"Single resource", utils.APPLICATIONS, "App1", false},
+		{"Single resource of a sub resource type", utils.CUSTOM_TEXTS, "login", false},
+		{"Single resource of a singleton resource type", utils.VALIDATION_RULES, "rules", true},
+	}
+
+	defer func() { utils.TOOL_CONFIGS = utils.ToolConfigs{} }()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			utils.TOOL_CONFIGS = utils.ToolConfigs{Exclude: []string{tc.resourceType.String()}}
+
+			err := utils.IncludeOnlyResource(tc.resourceType, tc.resourceName)
+			if (err != nil) != tc.expectError {
+				t.Fatalf("Unexpected error result for %s: %v", tc.name, err)
+			}
+			if tc.expectError {
+				return
+			}
+			if utils.IsResourceTypeExcluded(tc.resourceType) || !utils.IsResourceTypeExcluded(utils.CLAIMS) {
+				t.Errorf("Expected only %s to be included", tc.resourceType)
+			}
+			resourceConfigs := utils.GetResourceToolConfigs(tc.resourceType)
+			if tc.resourceName != "" && (utils.IsResourceExcluded(tc.resourceName, resourceConfigs) ||
+				!utils.IsResourceExcluded("other", resourceConfigs)) {
+				t.Errorf("Expected only %s to be included", tc.resourceName)
+			}
+		})
+	}
+}